@@ -2,17 +2,20 @@ package land
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"reflect"
 	"strings"
 )
 
 type AlterTableQuery interface {
+	Context(context context.Context) AlterTableQuery
 	AddColumn(name, dataType string, options ...ColOpts) AlterTableQuery
 	RenameColumn(currentName, newName string) AlterTableQuery
 	DropColumn(name string) AlterTableQuery
 	GetSQL() string
 	Exec()
+	ExecE(ctx context.Context) (sql.Result, error)
 	IfExists() AlterTableQuery
 }
 
@@ -61,6 +64,15 @@ func (q *alterTableQueryBuilder) Exec() {
 	createQueryManager(q.entity, q.context).setQuery(q.GetSQL()).setQueryType(AlterTable).exec()
 }
 
+func (q *alterTableQueryBuilder) ExecE(ctx context.Context) (sql.Result, error) {
+	return createQueryManager(q.entity, ctx).setQuery(q.GetSQL()).setQueryType(AlterTable).execE()
+}
+
+func (q *alterTableQueryBuilder) Context(context context.Context) AlterTableQuery {
+	q.context = context
+	return q
+}
+
 func (q *alterTableQueryBuilder) GetSQL() string {
 	return q.createQueryString()
 }
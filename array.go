@@ -0,0 +1,184 @@
+package land
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+type arrayLiteral struct {
+	text     string
+	elemType reflect.Type
+}
+
+var (
+	ErrMalformedArray = errors.New("land: malformed array literal")
+)
+
+var arrayElemTypes = map[string]reflect.Type{
+	"_int2":        reflect.TypeOf(0),
+	"_int4":        reflect.TypeOf(0),
+	"_int8":        reflect.TypeOf(0),
+	"_float4":      reflect.TypeOf(0.0),
+	"_float8":      reflect.TypeOf(0.0),
+	"_bool":        reflect.TypeOf(false),
+	"_date":        timeType,
+	"_timestamp":   timeType,
+	"_timestamptz": timeType,
+}
+
+func isArrayType(dataType string) bool {
+	return strings.HasSuffix(dataType, "[]")
+}
+
+func createArrayValue(value any) any {
+	return pq.Array(value)
+}
+
+func createArrayLiteral(typeName string, text string) arrayLiteral {
+	elemType, ok := arrayElemTypes[typeName]
+	if !ok {
+		elemType = reflect.TypeOf("")
+	}
+	return arrayLiteral{text: text, elemType: elemType}
+}
+
+func (a arrayLiteral) decode() (any, error) {
+	result, err := decodeArray(reflect.SliceOf(a.elemType), a.text)
+	if err != nil {
+		return nil, err
+	}
+	return result.Interface(), nil
+}
+
+func decodeArray(t reflect.Type, text string) (reflect.Value, error) {
+	result := reflect.New(t).Elem()
+	items, err := parseArray(text)
+	if err != nil {
+		return result, err
+	}
+	for _, item := range items {
+		elem := reflect.New(t.Elem()).Elem()
+		if item != nil {
+			if err := assignArrayItem(elem, *item); err != nil {
+				return result, err
+			}
+		}
+		result = reflect.Append(result, elem)
+	}
+	return result, nil
+}
+
+func assignArrayItem(dest reflect.Value, item string) error {
+	if dest.CanAddr() && dest.Addr().Type().Implements(scannerType) {
+		return dest.Addr().Interface().(sql.Scanner).Scan(item)
+	}
+	switch {
+	case dest.Kind() == reflect.Ptr:
+		elem := reflect.New(dest.Type().Elem())
+		if err := assignArrayItem(elem.Elem(), item); err != nil {
+			return err
+		}
+		dest.Set(elem)
+	case dest.Kind() == reflect.String:
+		dest.SetString(item)
+	case dest.Kind() == reflect.Interface:
+		dest.Set(reflect.ValueOf(item))
+	case dest.Kind() == reflect.Bool:
+		switch item {
+		case "t", "true":
+			dest.SetBool(true)
+		case "f", "false":
+			dest.SetBool(false)
+		default:
+			return fmt.Errorf("land: malformed array item %q", item)
+		}
+	case isIntKind(dest.Kind()) && dest.CanInt():
+		v, err := strconv.ParseInt(item, 10, 64)
+		if err != nil {
+			return fmt.Errorf("land: malformed array item %q: %w", item, err)
+		}
+		dest.SetInt(v)
+	case isIntKind(dest.Kind()):
+		v, err := strconv.ParseUint(item, 10, 64)
+		if err != nil {
+			return fmt.Errorf("land: malformed array item %q: %w", item, err)
+		}
+		dest.SetUint(v)
+	case isFloatKind(dest.Kind()):
+		v, err := strconv.ParseFloat(item, 64)
+		if err != nil {
+			return fmt.Errorf("land: malformed array item %q: %w", item, err)
+		}
+		dest.SetFloat(v)
+	case dest.Type() == timeType:
+		v, err := pq.ParseTimestamp(nil, item)
+		if err != nil {
+			return fmt.Errorf("land: malformed array item %q: %w", item, err)
+		}
+		dest.Set(reflect.ValueOf(v))
+	default:
+		return fmt.Errorf("land: cannot assign array item to %s", dest.Type())
+	}
+	return nil
+}
+
+func parseArray(text string) ([]*string, error) {
+	if len(text) < 2 || text[0] != '{' || text[len(text)-1] != '}' {
+		return nil, ErrMalformedArray
+	}
+	body := text[1 : len(text)-1]
+	result := make([]*string, 0)
+	if len(body) == 0 {
+		return result, nil
+	}
+	for i := 0; i <= len(body); {
+		if i < len(body) && body[i] == '{' {
+			return nil, fmt.Errorf("%w: multidimensional arrays are not supported", ErrMalformedArray)
+		}
+		item, next, quoted, err := parseArrayItem(body, i)
+		if err != nil {
+			return nil, err
+		}
+		if !quoted && strings.EqualFold(item, "NULL") {
+			result = append(result, nil)
+		} else {
+			result = append(result, &item)
+		}
+		if next < len(body) && body[next] != ',' {
+			return nil, ErrMalformedArray
+		}
+		i = next + 1
+	}
+	return result, nil
+}
+
+func parseArrayItem(body string, start int) (string, int, bool, error) {
+	if start >= len(body) || body[start] != '"' {
+		end := strings.IndexByte(body[start:], ',')
+		if end < 0 {
+			end = len(body) - start
+		}
+		return strings.TrimSpace(body[start : start+end]), start + end, false, nil
+	}
+	var item strings.Builder
+	for i := start + 1; i < len(body); i++ {
+		switch body[i] {
+		case '\\':
+			i++
+			if i < len(body) {
+				item.WriteByte(body[i])
+			}
+		case '"':
+			return item.String(), i + 1, true, nil
+		default:
+			item.WriteByte(body[i])
+		}
+	}
+	return "", 0, false, ErrMalformedArray
+}
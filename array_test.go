@@ -0,0 +1,106 @@
+package land
+
+import (
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testArrayModel struct {
+	Tags   []string
+	Scores []float64
+	Flags  []bool
+	Dates  []time.Time
+}
+
+func testArrayEntity(l Land) Entity {
+	return l.CreateEntity(testEntityName).
+		SetAlias(testEntityAlias).
+		SetColumn("tags", ArrayText, ColOpts{}).
+		SetColumn("scores", ArrayFloat, ColOpts{}).
+		SetColumn("flags", ArrayBool, ColOpts{}).
+		SetColumn("dates", ArrayTimestamp, ColOpts{})
+}
+
+func testArrayArg(t *testing.T, arg any) any {
+	value, err := arg.(driver.Valuer).Value()
+	assert.NoError(t, err)
+	return value
+}
+
+func TestParseArray(t *testing.T) {
+	test := assert.New(t)
+	items, err := parseArray(`{a,"b,c",NULL,"NULL","d\"e",""}`)
+	test.NoError(err)
+	test.Len(items, 6)
+	test.Equal("a", *items[0])
+	test.Equal("b,c", *items[1])
+	test.Nil(items[2])
+	test.Equal("NULL", *items[3])
+	test.Equal(`d"e`, *items[4])
+	test.Equal("", *items[5])
+	items, err = parseArray("{}")
+	test.NoError(err)
+	test.Empty(items)
+	_, err = parseArray(`{"a`)
+	test.ErrorIs(err, ErrMalformedArray)
+	_, err = parseArray("{{1,2},{3,4}}")
+	test.ErrorIs(err, ErrMalformedArray)
+}
+
+func TestInsertArray(t *testing.T) {
+	test := assert.New(t)
+	date := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	q := testArrayEntity(testCreatePostgresInstance()).
+		Insert().
+		SetValues(testArrayModel{Tags: []string{"go", "a,b"}, Scores: []float64{1.5}, Flags: []bool{}, Dates: []time.Time{date}})
+	query, args := q.GetSQLWithArgs()
+	test.Equal(`INSERT INTO "tests" ("tags","scores","flags","dates") VALUES ($1,$2,$3,$4);`, query)
+	test.Equal(`{"go","a,b"}`, testArrayArg(t, args[0]))
+	test.Equal(`{1.5}`, testArrayArg(t, args[1]))
+	test.Equal(`{}`, testArrayArg(t, args[2]))
+	test.Equal(`{2023-01-02 03:04:05Z}`, testArrayArg(t, args[3]))
+}
+
+func TestSelectWhereArray(t *testing.T) {
+	test := assert.New(t)
+	q := testArrayEntity(testCreatePostgresInstance()).Select()
+	q.Where().Column("tags").Any("go")
+	q.Where().Column("tags").ArrayContains([]string{"go", "sql"})
+	q.Where().Column("tags").ContainedBy([]string{"go"})
+	q.Where().Column("tags").Overlaps([]string{"db"})
+	q.Where().Column(Id).Any([]int{1, 2})
+	q.Where().Column(Id).Not().Any([]int{3})
+	q.All()
+	query, args := q.GetSQLWithArgs()
+	test.Equal(
+		`SELECT * FROM "tests" AS "t" WHERE $1 = ANY("t"."tags") AND "t"."tags" @> $2 AND "t"."tags" <@ $3 AND "t"."tags" && $4 AND "t"."id" = ANY($5) AND "t"."id" != ALL($6);`,
+		query,
+	)
+	test.Equal("go", args[0])
+	test.Equal(`{"go","sql"}`, testArrayArg(t, args[1]))
+	test.Equal(`{1,2}`, testArrayArg(t, args[4]))
+}
+
+func TestScanArray(t *testing.T) {
+	test := assert.New(t)
+	m := createQueryManager(nil, nil)
+	raw := any([]byte(`{"2023-01-02 03:04:05",NULL}`))
+	value, err := m.getScannedValue("_timestamp", &raw)
+	test.NoError(err)
+	var result testArrayModel
+	m.setDest(&result)
+	m.setValueToStruct(m.destRef.v, "dates", value)
+	m.setValueToStruct(m.destRef.v, "tags", createArrayLiteral("_text", `{go,"a,b",NULL}`))
+	m.setValueToStruct(m.destRef.v, "flags", createArrayLiteral("_bool", `{t,f}`))
+	test.Equal([]time.Time{time.Date(2023, 1, 2, 3, 4, 5, 0, time.FixedZone("", 0)), {}}, result.Dates)
+	test.Equal([]string{"go", "a,b", ""}, result.Tags)
+	test.Equal([]bool{true, false}, result.Flags)
+	rows := make([]map[string]any, 0)
+	m = createQueryManager(nil, nil).setDest(&rows)
+	row := m.createRowDataModel()
+	m.setValue(row, "ids", createArrayLiteral("_int4", `{1,NULL,3}`))
+	test.Equal([]int{1, 0, 3}, row.Interface().(map[string]any)["Ids"])
+}
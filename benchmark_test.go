@@ -0,0 +1,146 @@
+package land
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"testing"
+	"time"
+)
+
+type testBenchDriver struct{}
+
+type testBenchConn struct{}
+
+type testBenchRows struct {
+	count int
+	index int
+}
+
+type testBenchModel struct {
+	Id        int
+	Name      string
+	Lastname  string
+	Active    bool
+	Score     float64
+	CreatedAt time.Time
+}
+
+var (
+	testBenchColumns   = []string{"id", "name", "lastname", "active", "score", "created_at"}
+	testBenchTypeNames = []string{"INT4", "VARCHAR", "VARCHAR", "BOOL", "FLOAT8", "TIMESTAMP"}
+	testBenchRowsCount = 1000
+)
+
+func init() {
+	sql.Register("landbench", testBenchDriver{})
+}
+
+func (testBenchDriver) Open(name string) (driver.Conn, error) {
+	return testBenchConn{}, nil
+}
+
+func (testBenchConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, driver.ErrSkip
+}
+
+func (testBenchConn) Close() error {
+	return nil
+}
+
+func (testBenchConn) Begin() (driver.Tx, error) {
+	return nil, driver.ErrSkip
+}
+
+func (testBenchConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return &testBenchRows{count: testBenchRowsCount}, nil
+}
+
+func (r *testBenchRows) Columns() []string {
+	return testBenchColumns
+}
+
+func (r *testBenchRows) ColumnTypeDatabaseTypeName(index int) string {
+	return testBenchTypeNames[index]
+}
+
+func (r *testBenchRows) Close() error {
+	return nil
+}
+
+func (r *testBenchRows) Next(dest []driver.Value) error {
+	if r.index >= r.count {
+		return io.EOF
+	}
+	r.index++
+	dest[0] = int64(r.index)
+	dest[1] = []byte("Dominik")
+	dest[2] = []byte("Linduska")
+	dest[3] = true
+	dest[4] = 1.5
+	dest[5] = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	return nil
+}
+
+func testBenchLand(b *testing.B) Land {
+	connection, err := sql.Open("landbench", "")
+	if err != nil {
+		b.Fatal(err)
+	}
+	return &land{
+		db:       &db{connection: connection, connector: Connect().Postgres().getPtr()},
+		entities: make([]*entity, 0),
+		config:   Config{},
+	}
+}
+
+func testBenchEntity(l Land) Entity {
+	return testEntity(l).SetColumn("score", Float, ColOpts{})
+}
+
+func BenchmarkScanStructs(b *testing.B) {
+	q := testBenchEntity(testBenchLand(b)).Select()
+	q.All()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		result := make([]testBenchModel, 0)
+		if err := q.ScanE(context.Background(), &result); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkScanMaps(b *testing.B) {
+	q := testBenchEntity(testBenchLand(b)).Select()
+	q.All()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		result := make([]map[string]any, 0)
+		if err := q.ScanE(context.Background(), &result); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkInsertSetValues(b *testing.B) {
+	e := testBenchEntity(testBenchLand(b))
+	model := testBenchModel{Name: "Dominik", Lastname: "Linduska", Active: true, Score: 1.5}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		e.Insert().SetValues(model).GetSQLWithArgs()
+	}
+}
+
+func BenchmarkUpdateSetValues(b *testing.B) {
+	e := testBenchEntity(testBenchLand(b))
+	model := testBenchModel{Name: "Dominik", Lastname: "Linduska", Active: true, Score: 1.5}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		e.Update().SetValues(model).GetSQLWithArgs()
+	}
+}
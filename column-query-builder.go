@@ -39,7 +39,7 @@ type columnQueryBuilder struct {
 	entity            *entity
 	alias             string
 	name              string
-	subquery          string
+	subquery          *selectQueryBuilder
 	subqueryExist     bool
 	webalize          bool
 	use               bool
@@ -119,8 +119,8 @@ func (q *columnQueryBuilder) Not() ColumnQuery {
 }
 
 func (q *columnQueryBuilder) Subquery(query SelectQuery) ColumnQuery {
-	q.subquery = strings.TrimSuffix(query.GetSQL(), q.getQueryDivider())
-	q.subqueryExist = len(q.subquery) > 0
+	q.subquery = query.getPtr()
+	q.subqueryExist = q.subquery != nil
 	return q
 }
 
@@ -216,7 +216,8 @@ func (q *columnQueryBuilder) getQueryString() string {
 		colSql = append(colSql, q.escape(q.entity.alias)+q.getCoupler())
 	}
 	if q.subqueryExist {
-		colSql = append(colSql, fmt.Sprintf("(%s)", q.subquery))
+		q.shareArgs(q.subquery.queryBuilder)
+		colSql = append(colSql, fmt.Sprintf("(%s)", q.subquery.createQueryString()))
 	}
 	if !q.subqueryExist {
 		colSql = append(colSql, q.escape(q.name))
@@ -247,7 +248,7 @@ func (q *columnQueryBuilder) shouldUseEmpty() bool {
 }
 
 func (q *columnQueryBuilder) createEmptyString() string {
-	return fmt.Sprintf("'%s'", q.separator)
+	return q.quote(q.separator)
 }
 
 func (q *columnQueryBuilder) createAggregateWrapper(col string) string {
@@ -275,13 +276,7 @@ func (q *columnQueryBuilder) createAggregateWrapper(col string) string {
 }
 
 func (q *columnQueryBuilder) createCompare(column string) string {
-	var value string
-	switch q.compareValue.(type) {
-	case string:
-		value = fmt.Sprintf("'%v'", value)
-	default:
-		value = fmt.Sprintf("%v", value)
-	}
+	value := q.bind(q.compareValue)
 	switch q.compareExpression {
 	case compareGreater:
 		return fmt.Sprintf("%s > %s", column, value)
@@ -312,7 +307,7 @@ func (q *columnQueryBuilder) createCoalesceAggWrapper(col string) string {
 			result = append(result, fmt.Sprintf("%s.%s", strings.ToLower(c.entity.alias), strcase.ToSnake(coalesceColumn)))
 		}
 		for _, coalesceValue := range c.values {
-			result = append(result, fmt.Sprintf("%v", coalesceValue))
+			result = append(result, q.bind(coalesceValue))
 		}
 	}
 	return fmt.Sprintf("COALESCE(%s)", strings.Join(result, q.getColumnsDivider()))
@@ -322,14 +317,15 @@ func (q *columnQueryBuilder) createStringAggWrapper(col string) string {
 	stringAggCols := make([]string, 0)
 	stringAggCols = append(stringAggCols, col)
 	for _, c := range q.columns {
+		q.shareArgs(c.queryBuilder)
 		stringAggCols = append(stringAggCols, c.getQueryString())
 	}
 	return fmt.Sprintf(
-		"STRING_AGG(%s, '%s')",
+		"STRING_AGG(%s, %s)",
 		strings.Join(
 			stringAggCols,
 			fmt.Sprintf(" %s ", operatorDoublePipe),
 		),
-		q.separator,
+		q.quote(q.separator),
 	)
 }
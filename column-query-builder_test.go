@@ -62,3 +62,12 @@ func TestColumnStringAgg(t *testing.T) {
 	c1.StringAgg(empty, c2).Separator(",").Alias("value")
 	test.Equal(`STRING_AGG("t"."name" || ' ' || "t"."lastname", ',') AS "value"`, c1.getQueryString())
 }
+
+func TestColumnCompare(t *testing.T) {
+	test := assert.New(t)
+	e := testEntity(testCreatePostgresInstance())
+	c := createColumnQuery(e.getPtr(), "name")
+	c.Equal("O'Brien").Alias("is_obrien")
+	test.Equal(`"t"."name" = $1 AS "is_obrien"`, c.getQueryString())
+	test.Equal([]any{"O'Brien"}, c.getArgs())
+}
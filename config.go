@@ -4,4 +4,5 @@ type Config struct {
 	Production bool
 	Log        bool
 	Timezone   bool
+	Logger     Logger
 }
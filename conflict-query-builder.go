@@ -0,0 +1,159 @@
+package land
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type ConflictQuery interface {
+	DoNothing() ConflictQuery
+	DoUpdate() ConflictQuery
+	Set(columns ...string) ConflictQuery
+	Where(entity ...Entity) ConditionQuery
+}
+
+type conflictQueryBuilder struct {
+	*queryBuilder
+	entity     *entity
+	columns    []string
+	constraint string
+	action     string
+	sets       []string
+	wheres     []*conditionQueryBuilder
+}
+
+const (
+	conflictDoNothing = "DO NOTHING"
+	conflictDoUpdate  = "DO UPDATE"
+)
+
+var (
+	ErrConflictTarget = errors.New("land: on conflict do update requires conflict columns or constraint")
+	ErrConflictSet    = errors.New("land: on conflict do update has no columns to set")
+)
+
+func createConflictQuery(entity *entity) *conflictQueryBuilder {
+	return &conflictQueryBuilder{
+		queryBuilder: createQueryBuilder().setQueryType(Conflict),
+		entity:       entity,
+		action:       conflictDoNothing,
+		columns:      make([]string, 0),
+		sets:         make([]string, 0),
+		wheres:       make([]*conditionQueryBuilder, 0),
+	}
+}
+
+func (q *conflictQueryBuilder) DoNothing() ConflictQuery {
+	q.action = conflictDoNothing
+	return q
+}
+
+func (q *conflictQueryBuilder) DoUpdate() ConflictQuery {
+	q.action = conflictDoUpdate
+	return q
+}
+
+func (q *conflictQueryBuilder) Set(columns ...string) ConflictQuery {
+	q.action = conflictDoUpdate
+	q.sets = append(q.sets, columns...)
+	return q
+}
+
+func (q *conflictQueryBuilder) Where(entity ...Entity) ConditionQuery {
+	e := q.entity
+	if len(entity) > 0 {
+		e = entity[0].getPtr()
+	}
+	where := createConditionQuery(e)
+	q.wheres = append(q.wheres, where)
+	return where
+}
+
+func (q *conflictQueryBuilder) validate(columns []*column) error {
+	if q.action != conflictDoUpdate {
+		return nil
+	}
+	if len(q.constraint) == 0 && len(q.columns) == 0 {
+		return ErrConflictTarget
+	}
+	if len(q.getSets(columns)) == 0 {
+		return ErrConflictSet
+	}
+	return nil
+}
+
+func (q *conflictQueryBuilder) createQueryString(columns []*column) string {
+	result := make([]string, 0)
+	result = append(result, "ON", "CONFLICT")
+	if len(q.constraint) > 0 {
+		result = append(result, "ON", "CONSTRAINT", q.escape(q.constraint))
+	}
+	if len(q.constraint) == 0 && len(q.columns) > 0 {
+		conflictColumns := make([]string, len(q.columns))
+		for i, c := range q.columns {
+			conflictColumns[i] = q.escape(c)
+		}
+		result = append(result, "("+strings.Join(conflictColumns, q.getColumnsDivider())+")")
+	}
+	result = append(result, q.action)
+	if q.action == conflictDoNothing {
+		return strings.Join(result, " ")
+	}
+	result = append(result, "SET", strings.Join(q.getSets(columns), q.getColumnsDivider()))
+	result = append(result, q.createWheresPart()...)
+	return strings.Join(result, " ")
+}
+
+func (q *conflictQueryBuilder) getSets(columns []*column) []string {
+	result := make([]string, 0)
+	for _, c := range columns {
+		if c.name == Id || c.name == CreatedAt || c.name == UpdatedAt || c.name == Version {
+			continue
+		}
+		if len(q.sets) == 0 && slices.Contains(q.columns, c.name) {
+			continue
+		}
+		if len(q.sets) > 0 && !slices.Contains(q.sets, c.name) {
+			continue
+		}
+		result = append(result, q.escape(c.name)+" = EXCLUDED."+q.escape(c.name))
+	}
+	for _, c := range q.entity.columns {
+		if c.name == UpdatedAt {
+			result = append(result, q.escape(c.name)+" = "+CurrentTimestamp)
+		}
+		if c.name == Version {
+			result = append(result, fmt.Sprintf("%[1]s = %[2]s%[3]s%[1]s + 1", q.escape(c.name), q.escape(q.getTableAlias()), q.getCoupler()))
+		}
+	}
+	return result
+}
+
+func (q *conflictQueryBuilder) getTableAlias() string {
+	if len(q.entity.alias) > 0 {
+		return q.entity.alias
+	}
+	return q.entity.name
+}
+
+func (q *conflictQueryBuilder) createWheresPart() []string {
+	result := make([]string, 0)
+	for i, where := range q.wheres {
+		if where.excludeFromZeroLevel {
+			continue
+		}
+		condition := make([]string, 0)
+		if i == 0 {
+			condition = append(condition, "WHERE")
+		}
+		if i > 0 {
+			condition = append(condition, "AND")
+		}
+		q.shareArgs(where.queryBuilder)
+		condition = append(condition, where.createQueryString())
+		result = append(result, strings.Join(condition, " "))
+	}
+	return result
+}
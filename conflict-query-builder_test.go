@@ -0,0 +1,75 @@
+package land
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInsertOnConflictDoNothing(t *testing.T) {
+	test := assert.New(t)
+	q := testEntity(testCreatePostgresInstance()).Insert().SetValues(testModel{Name: "Dominik", Lastname: "Linduska"})
+	q.OnConflict(testName).DoNothing()
+	q.Return(Id)
+	query, args := q.GetSQLWithArgs()
+	test.Equal(
+		`INSERT INTO "tests" AS "t" ("name","lastname","active","vectors","created_at","updated_at") VALUES ($1,$2,$3,to_tsvector(''),CURRENT_TIMESTAMP,CURRENT_TIMESTAMP) ON CONFLICT ("name") DO NOTHING RETURNING "id";`,
+		query,
+	)
+	test.Equal([]any{"Dominik", "Linduska", false}, args)
+}
+
+func TestInsertOnConflictDoUpdate(t *testing.T) {
+	test := assert.New(t)
+	q := testEntity(testCreatePostgresInstance()).Insert().SetValues(testModel{Name: "Dominik", Lastname: "Linduska"})
+	q.OnConflict(testName).DoUpdate()
+	query := q.GetSQL()
+	test.Equal(
+		`INSERT INTO "tests" AS "t" ("name","lastname","active","vectors","created_at","updated_at") VALUES ($1,$2,$3,to_tsvector(''),CURRENT_TIMESTAMP,CURRENT_TIMESTAMP) ON CONFLICT ("name") DO UPDATE SET "lastname" = EXCLUDED."lastname","active" = EXCLUDED."active","vectors" = EXCLUDED."vectors","updated_at" = CURRENT_TIMESTAMP;`,
+		query,
+	)
+}
+
+func TestInsertOnConstraintSet(t *testing.T) {
+	test := assert.New(t)
+	q := testEntity(testCreatePostgresInstance()).Insert().SetValues(testModel{Name: "Dominik", Lastname: "Linduska"})
+	conflict := q.OnConstraint("tests_name_key").Set(testLastname)
+	conflict.Where().Column(testActive).Equal(true)
+	query, args := q.GetSQLWithArgs()
+	test.Equal(
+		`INSERT INTO "tests" AS "t" ("name","lastname","active","vectors","created_at","updated_at") VALUES ($1,$2,$3,to_tsvector(''),CURRENT_TIMESTAMP,CURRENT_TIMESTAMP) ON CONFLICT ON CONSTRAINT "tests_name_key" DO UPDATE SET "lastname" = EXCLUDED."lastname","updated_at" = CURRENT_TIMESTAMP WHERE "t"."active" = $4;`,
+		query,
+	)
+	test.Equal([]any{"Dominik", "Linduska", false, true}, args)
+}
+
+func TestInsertOnConflictTarget(t *testing.T) {
+	test := assert.New(t)
+	l, fake := testFakeLand(t)
+	q := testEntity(l).Insert().SetValues(testModel{Name: "Dominik"})
+	q.OnConflict().DoUpdate()
+	_, err := q.ExecE(context.Background())
+	test.ErrorIs(err, ErrConflictTarget)
+	test.Panics(func() {
+		q.Exec()
+	})
+	q.OnConflict().DoNothing()
+	_, err = q.ExecE(context.Background())
+	test.NoError(err)
+	test.Len(fake.getQueries(), 1)
+}
+
+func TestInsertOnConflictSet(t *testing.T) {
+	test := assert.New(t)
+	l, fake := testFakeLand(t)
+	e := l.CreateEntity("tags").SetColumn(testName, Varchar)
+	q := e.Insert().SetValues(map[string]any{"name": "go"})
+	q.OnConflict(testName).DoUpdate()
+	_, err := q.ExecE(context.Background())
+	test.ErrorIs(err, ErrConflictSet)
+	q.OnConflict(testName).Set(testLastname)
+	_, err = q.ExecE(context.Background())
+	test.ErrorIs(err, ErrConflictSet)
+	test.Empty(fake.getQueries())
+}
@@ -1,14 +1,24 @@
 package land
 
 import (
+	"crypto/tls"
+	"database/sql"
 	"fmt"
-	"log"
+	"net/url"
 	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
+
+	"github.com/lib/pq"
 )
 
 type Connector interface {
 	Postgres() Connector
+	URL(dsn string) Connector
+	FromEnv() Connector
 	Host(host string) Connector
 	Port(port int) Connector
 	User(user string) Connector
@@ -16,24 +26,50 @@ type Connector interface {
 	Password(password string) Connector
 	SSL(sslmode string) Connector
 	CertPath(path string) Connector
+	SSLCert(path string) Connector
+	SSLKey(path string) Connector
+	SSLRootCert(path string) Connector
+	TLSConfig(config *tls.Config) Connector
+	MaxOpenConns(n int) Connector
+	MaxIdleConns(n int) Connector
+	ConnMaxLifetime(d time.Duration) Connector
+	ConnMaxIdleTime(d time.Duration) Connector
+	ApplicationName(name string) Connector
+	ConnectTimeout(d time.Duration) Connector
+	StatementTimeout(d time.Duration) Connector
+	SearchPath(schemas ...string) Connector
 
 	getPtr() *connector
 }
 
 type connector struct {
-	dbtype   string
-	host     string
-	port     int
-	user     string
-	dbname   string
-	password string
-	sslmode  string
-	certpath string
+	dbtype           string
+	host             string
+	port             int
+	user             string
+	dbname           string
+	password         string
+	sslmode          string
+	certpath         string
+	sslcert          string
+	sslkey           string
+	tlsConfig        *tls.Config
+	applicationName  string
+	searchPath       []string
+	connectTimeout   time.Duration
+	statementTimeout time.Duration
+	maxOpenConns     int
+	maxIdleConns     int
+	connMaxLifetime  time.Duration
+	connMaxIdleTime  time.Duration
+	params           map[string]string
+	err              error
 }
 
 func Connect() Connector {
 	return &connector{
 		sslmode: SSLDisable,
+		params:  make(map[string]string),
 	}
 }
 
@@ -41,11 +77,90 @@ const (
 	Postgres string = "postgres"
 )
 
+const (
+	envHost     = "PGHOST"
+	envPort     = "PGPORT"
+	envUser     = "PGUSER"
+	envPassword = "PGPASSWORD"
+	envDbname   = "PGDATABASE"
+	envSSLMode  = "PGSSLMODE"
+	envSSLCert  = "PGSSLCERT"
+	envSSLKey   = "PGSSLKEY"
+	envSSLRoot  = "PGSSLROOTCERT"
+)
+
 func (c *connector) Postgres() Connector {
 	c.dbtype = Postgres
 	return c
 }
 
+func (c *connector) URL(dsn string) Connector {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		c.err = fmt.Errorf("land: invalid connection url: %w", err)
+		return c
+	}
+	if u.Scheme != "postgres" && u.Scheme != "postgresql" {
+		c.err = fmt.Errorf("land: unsupported connection url scheme %q", u.Scheme)
+		return c
+	}
+	c.dbtype = Postgres
+	if u.User != nil {
+		c.user = u.User.Username()
+		if password, ok := u.User.Password(); ok {
+			c.password = password
+		}
+	}
+	if len(u.Hostname()) > 0 {
+		c.host = u.Hostname()
+	}
+	if len(u.Port()) > 0 {
+		c.setPort(u.Port())
+	}
+	if dbname := strings.TrimPrefix(u.Path, "/"); len(dbname) > 0 {
+		c.dbname = dbname
+	}
+	for key, values := range u.Query() {
+		if len(values) == 0 {
+			continue
+		}
+		c.setParam(key, values[len(values)-1])
+	}
+	return c
+}
+
+func (c *connector) FromEnv() Connector {
+	c.dbtype = Postgres
+	if host := os.Getenv(envHost); len(host) > 0 {
+		c.host = host
+	}
+	if port := os.Getenv(envPort); len(port) > 0 {
+		c.setPort(port)
+	}
+	if user := os.Getenv(envUser); len(user) > 0 {
+		c.user = user
+	}
+	if password := os.Getenv(envPassword); len(password) > 0 {
+		c.password = password
+	}
+	if dbname := os.Getenv(envDbname); len(dbname) > 0 {
+		c.dbname = dbname
+	}
+	if sslmode := os.Getenv(envSSLMode); len(sslmode) > 0 {
+		c.sslmode = sslmode
+	}
+	if sslcert := os.Getenv(envSSLCert); len(sslcert) > 0 {
+		c.sslcert = c.createCertPath(sslcert)
+	}
+	if sslkey := os.Getenv(envSSLKey); len(sslkey) > 0 {
+		c.sslkey = c.createCertPath(sslkey)
+	}
+	if sslrootcert := os.Getenv(envSSLRoot); len(sslrootcert) > 0 {
+		c.certpath = c.createCertPath(sslrootcert)
+	}
+	return c
+}
+
 func (c *connector) Host(host string) Connector {
 	c.host = host
 	return c
@@ -77,52 +192,235 @@ func (c *connector) SSL(sslmode string) Connector {
 }
 
 func (c *connector) CertPath(path string) Connector {
-	c.certpath = c.createFullCertPath(path)
+	return c.SSLRootCert(path)
+}
+
+func (c *connector) SSLCert(path string) Connector {
+	c.sslcert = c.createCertPath(path)
+	return c
+}
+
+func (c *connector) SSLKey(path string) Connector {
+	c.sslkey = c.createCertPath(path)
+	return c
+}
+
+func (c *connector) SSLRootCert(path string) Connector {
+	c.certpath = c.createCertPath(path)
+	return c
+}
+
+func (c *connector) TLSConfig(config *tls.Config) Connector {
+	c.tlsConfig = config
+	return c
+}
+
+func (c *connector) MaxOpenConns(n int) Connector {
+	c.maxOpenConns = n
+	return c
+}
+
+func (c *connector) MaxIdleConns(n int) Connector {
+	c.maxIdleConns = n
+	return c
+}
+
+func (c *connector) ConnMaxLifetime(d time.Duration) Connector {
+	c.connMaxLifetime = d
+	return c
+}
+
+func (c *connector) ConnMaxIdleTime(d time.Duration) Connector {
+	c.connMaxIdleTime = d
+	return c
+}
+
+func (c *connector) ApplicationName(name string) Connector {
+	c.applicationName = name
+	return c
+}
+
+func (c *connector) ConnectTimeout(d time.Duration) Connector {
+	c.connectTimeout = d
+	return c
+}
+
+func (c *connector) StatementTimeout(d time.Duration) Connector {
+	c.statementTimeout = d
+	return c
+}
+
+func (c *connector) SearchPath(schemas ...string) Connector {
+	c.searchPath = schemas
 	return c
 }
 
-func (c *connector) createFullCertPath(path string) string {
-	root, err := os.Getwd()
+func (c *connector) setPort(port string) {
+	p, err := strconv.Atoi(port)
 	if err != nil {
-		log.Fatalln(err)
+		c.err = fmt.Errorf("land: invalid port %q", port)
+		return
 	}
-	result := root
-	if strings.HasSuffix(result, "/") {
-		result = strings.TrimSuffix(result, "/")
+	c.port = p
+}
+
+func (c *connector) setParam(key, value string) {
+	switch key {
+	case "sslmode":
+		c.sslmode = value
+	case "sslrootcert":
+		c.certpath = c.createCertPath(value)
+	case "sslcert":
+		c.sslcert = c.createCertPath(value)
+	case "sslkey":
+		c.sslkey = c.createCertPath(value)
+	case "application_name":
+		c.applicationName = value
+	case "search_path":
+		c.searchPath = strings.Split(value, ",")
+	case "connect_timeout":
+		seconds, err := strconv.Atoi(value)
+		if err != nil {
+			c.err = fmt.Errorf("land: invalid connect_timeout %q", value)
+			return
+		}
+		c.connectTimeout = time.Duration(seconds) * time.Second
+	default:
+		c.params[key] = value
 	}
-	if !strings.HasSuffix(path, "/") {
-		path = "/" + path
+}
+
+func (c *connector) createCertPath(path string) string {
+	if filepath.IsAbs(path) {
+		return filepath.Clean(path)
 	}
-	result += path
-	return result
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return path
+	}
+	return absPath
+}
+
+func (c *connector) validate() error {
+	if c.err != nil {
+		return c.err
+	}
+	if c.dbtype != Postgres {
+		return ErrNoDatabase
+	}
+	return nil
+}
+
+func (c *connector) open() (*sql.DB, error) {
+	if c.tlsConfig != nil {
+		pqConnector, err := pq.NewConnector(c.createConnectionStringWithSSL(SSLDisable))
+		if err != nil {
+			return nil, err
+		}
+		pqConnector.Dialer(createTLSDialer(c.tlsConfig))
+		return sql.OpenDB(pqConnector), nil
+	}
+	if c.sslmode == SSLPrefer || c.sslmode == SSLAllow {
+		fallbackConnector, err := createSSLFallbackConnector(c)
+		if err != nil {
+			return nil, err
+		}
+		return sql.OpenDB(fallbackConnector), nil
+	}
+	return sql.Open(c.dbtype, c.createConnectionString())
 }
 
 func (c *connector) createConnectionString() string {
+	return c.createConnectionStringWithSSL(c.sslmode)
+}
+
+func (c *connector) createConnectionStringWithSSL(sslmode string) string {
 	result := make([]string, 0)
 	if len(c.certpath) > 0 {
-		result = append(result, fmt.Sprintf("sslrootcert=%s", c.certpath))
+		result = append(result, c.createParam("sslrootcert", c.certpath))
+	}
+	if len(c.sslcert) > 0 {
+		result = append(result, c.createParam("sslcert", c.sslcert))
+	}
+	if len(c.sslkey) > 0 {
+		result = append(result, c.createParam("sslkey", c.sslkey))
 	}
 	if len(c.host) > 0 {
-		result = append(result, fmt.Sprintf("host=%s", c.host))
+		result = append(result, c.createParam("host", c.host))
 	}
 	if c.port > 0 {
-		result = append(result, fmt.Sprintf("port=%d", c.port))
+		result = append(result, c.createParam("port", strconv.Itoa(c.port)))
 	}
 	if len(c.user) > 0 {
-		result = append(result, fmt.Sprintf("user=%s", c.user))
+		result = append(result, c.createParam("user", c.user))
 	}
 	if len(c.password) > 0 {
-		result = append(result, fmt.Sprintf("password=%s", c.password))
+		result = append(result, c.createParam("password", c.password))
 	}
 	if len(c.dbname) > 0 {
-		result = append(result, fmt.Sprintf("dbname=%s", c.dbname))
+		result = append(result, c.createParam("dbname", c.dbname))
+	}
+	if len(sslmode) > 0 {
+		result = append(result, c.createParam("sslmode", sslmode))
+	}
+	if len(c.applicationName) > 0 {
+		result = append(result, c.createParam("application_name", c.applicationName))
 	}
-	if len(c.sslmode) > 0 {
-		result = append(result, fmt.Sprintf("sslmode=%s", c.sslmode))
+	if c.connectTimeout > 0 {
+		result = append(result, c.createParam("connect_timeout", strconv.Itoa(c.getConnectTimeoutSeconds())))
+	}
+	if c.statementTimeout > 0 {
+		result = append(result, c.createParam("statement_timeout", strconv.FormatInt(c.statementTimeout.Milliseconds(), 10)))
+	}
+	if len(c.searchPath) > 0 {
+		result = append(result, c.createParam("search_path", strings.Join(c.searchPath, ",")))
+	}
+	keys := make([]string, 0, len(c.params))
+	for key := range c.params {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		result = append(result, c.createParam(key, c.params[key]))
 	}
 	return strings.Join(result, " ")
 }
 
+func (c *connector) createParam(key, value string) string {
+	return key + "=" + c.quoteParamValue(value)
+}
+
+func (c *connector) quoteParamValue(value string) string {
+	if len(value) > 0 && !strings.ContainsAny(value, " '\\\t\n") {
+		return value
+	}
+	replacer := strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+	return "'" + replacer.Replace(value) + "'"
+}
+
+func (c *connector) getConnectTimeoutSeconds() int {
+	seconds := int(c.connectTimeout / time.Second)
+	if c.connectTimeout%time.Second > 0 {
+		seconds++
+	}
+	return seconds
+}
+
+func (c *connector) configurePool(connection *sql.DB) {
+	if c.maxOpenConns > 0 {
+		connection.SetMaxOpenConns(c.maxOpenConns)
+	}
+	if c.maxIdleConns > 0 {
+		connection.SetMaxIdleConns(c.maxIdleConns)
+	}
+	if c.connMaxLifetime > 0 {
+		connection.SetConnMaxLifetime(c.connMaxLifetime)
+	}
+	if c.connMaxIdleTime > 0 {
+		connection.SetConnMaxIdleTime(c.connMaxIdleTime)
+	}
+}
+
 func (c *connector) getPtr() *connector {
 	return c
 }
@@ -1,5 +1,7 @@
 package land
 
+import "time"
+
 // Query types
 const (
 	Select      = "SELECT"
@@ -16,6 +18,7 @@ const (
 	Column      = "COLUMN"
 	Columns     = "COLUMNS"
 	Group       = "GROUP"
+	Conflict    = "CONFLICT"
 )
 
 // Columns names
@@ -25,6 +28,8 @@ const (
 	Vectors          = "vectors"
 	CreatedAt        = "created_at"
 	UpdatedAt        = "updated_at"
+	Version          = "version"
+	DeletedAt        = "deleted_at"
 )
 
 // Data types
@@ -44,9 +49,15 @@ const (
 	Bool                     = "bool"
 	Byte                     = "byte"
 	Bytea                    = "bytea"
+	Json                     = "json"
 	Jsonb                    = "jsonb"
 	ArrayText                = "text[]"
 	ArrayInt                 = "integer[]"
+	ArrayBigInt              = "bigint[]"
+	ArrayFloat               = "float[]"
+	ArrayBool                = "boolean[]"
+	ArrayUuid                = "uuid[]"
+	ArrayTimestamp           = "timestamp[]"
 	TsVector                 = "tsvector"
 	Timestamp                = "timestamp"
 	TimestampWithZone        = "timestampz"
@@ -55,6 +66,7 @@ const (
 
 // Default values
 const (
-	DefaultLimit            = 20
-	CurrentTimestamp string = "CURRENT_TIMESTAMP"
+	DefaultLimit              = 20
+	DefaultPingTimeout        = 10 * time.Second
+	CurrentTimestamp   string = "CURRENT_TIMESTAMP"
 )
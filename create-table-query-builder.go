@@ -2,14 +2,17 @@ package land
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"reflect"
 	"strings"
 )
 
 type CreateTableQuery interface {
+	Context(context context.Context) CreateTableQuery
 	GetSQL() string
 	Exec()
+	ExecE(ctx context.Context) (sql.Result, error)
 	IfNotExists() CreateTableQuery
 }
 
@@ -28,6 +31,11 @@ func createCreateTableQuery(entity *entity) *createTableQueryBuilder {
 	}
 }
 
+func (q *createTableQueryBuilder) Context(context context.Context) CreateTableQuery {
+	q.context = context
+	return q
+}
+
 func (q *createTableQueryBuilder) GetSQL() string {
 	return q.createQueryString()
 }
@@ -36,6 +44,10 @@ func (q *createTableQueryBuilder) Exec() {
 	createQueryManager(q.entity, q.context).setQuery(q.GetSQL()).setQueryType(CreateTable).exec()
 }
 
+func (q *createTableQueryBuilder) ExecE(ctx context.Context) (sql.Result, error) {
+	return createQueryManager(q.entity, ctx).setQuery(q.GetSQL()).setQueryType(CreateTable).execE()
+}
+
 func (q *createTableQueryBuilder) IfNotExists() CreateTableQuery {
 	q.ifNotExists = true
 	return q
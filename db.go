@@ -1,8 +1,9 @@
 package land
 
 import (
+	"context"
 	"database/sql"
-	"log"
+	"errors"
 
 	_ "github.com/lib/pq"
 )
@@ -13,12 +14,48 @@ type db struct {
 	connection *sql.DB
 }
 
-func createConnection(config Config, connector *connector) *db {
+type executor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+type failedExecutor struct {
+	err error
+}
+
+var (
+	ErrNoConnector = errors.New("land: connector is required")
+	ErrNoDatabase  = errors.New("land: database type not set, use Postgres(), URL() or FromEnv()")
+)
+
+func (e failedExecutor) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	return nil, e.err
+}
+
+func (e failedExecutor) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	return nil, e.err
+}
+
+func createConnection(config Config, connector *connector) (*db, error) {
 	d := &db{config: config, connector: connector}
-	connection, err := sql.Open(connector.dbtype, connector.createConnectionString())
+	if err := connector.validate(); err != nil {
+		return nil, err
+	}
+	connection, err := connector.open()
 	if err != nil {
-		log.Fatalln(err)
+		return nil, err
 	}
+	connector.configurePool(connection)
 	d.connection = connection
-	return d
+	return d, nil
+}
+
+func (d *db) ping(ctx context.Context) error {
+	timeout := d.connector.connectTimeout
+	if timeout <= 0 {
+		timeout = DefaultPingTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return d.connection.PingContext(ctx)
 }
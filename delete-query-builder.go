@@ -2,15 +2,21 @@ package land
 
 import (
 	"context"
+	"database/sql"
 	"strings"
 )
 
 type DeleteQuery interface {
+	Context(context context.Context) DeleteQuery
 	GetSQL() string
+	GetSQLWithArgs() (string, []any)
 	Exec()
+	ExecE(ctx context.Context) (sql.Result, error)
 	GetResult(dest any)
+	ScanE(ctx context.Context, dest any) error
 	Return(columns ...string) DeleteQuery
 	Where(entity ...Entity) ConditionQuery
+	ForceDelete() DeleteQuery
 }
 
 type deleteQueryBuilder struct {
@@ -20,6 +26,7 @@ type deleteQueryBuilder struct {
 	wheres   []*conditionQueryBuilder
 	returns  []string
 	isReturn bool
+	force    bool
 }
 
 func createDeleteQuery(entity *entity) *deleteQueryBuilder {
@@ -32,16 +39,47 @@ func createDeleteQuery(entity *entity) *deleteQueryBuilder {
 	}
 }
 
+func (q *deleteQueryBuilder) Context(context context.Context) DeleteQuery {
+	q.context = context
+	return q
+}
+
 func (q *deleteQueryBuilder) GetSQL() string {
-	return q.createQueryString()
+	query, _ := q.GetSQLWithArgs()
+	return query
+}
+
+func (q *deleteQueryBuilder) GetSQLWithArgs() (string, []any) {
+	q.resetArgs()
+	return q.createQueryString(), q.getArgs()
 }
 
 func (q *deleteQueryBuilder) Exec() {
-	createQueryManager(q.entity, q.context).setQuery(q.GetSQL()).setQueryType(Delete).exec()
+	q.entity.errorManager.check(q.entity.runBeforeDelete(q.context, q), "")
+	query, args := q.GetSQLWithArgs()
+	createQueryManager(q.entity, q.context).setQuery(query).setArgs(args).setQueryType(Delete).exec()
 }
 
 func (q *deleteQueryBuilder) GetResult(dest any) {
-	createQueryManager(q.entity, q.context).setQuery(q.GetSQL()).setQueryType(Select).setDest(dest).getResult()
+	q.entity.errorManager.check(q.entity.runBeforeDelete(q.context, q), "")
+	query, args := q.GetSQLWithArgs()
+	createQueryManager(q.entity, q.context).setQuery(query).setArgs(args).setQueryType(Select).setDest(dest).getResult()
+}
+
+func (q *deleteQueryBuilder) ExecE(ctx context.Context) (sql.Result, error) {
+	if err := q.entity.runBeforeDelete(ctx, q); err != nil {
+		return nil, err
+	}
+	query, args := q.GetSQLWithArgs()
+	return createQueryManager(q.entity, ctx).setQuery(query).setArgs(args).setQueryType(Delete).execE()
+}
+
+func (q *deleteQueryBuilder) ScanE(ctx context.Context, dest any) error {
+	if err := q.entity.runBeforeDelete(ctx, q); err != nil {
+		return err
+	}
+	query, args := q.GetSQLWithArgs()
+	return createQueryManager(q.entity, ctx).setQuery(query).setArgs(args).setQueryType(Select).setDest(dest).getResultE()
 }
 
 func (q *deleteQueryBuilder) Return(columns ...string) DeleteQuery {
@@ -60,14 +98,42 @@ func (q *deleteQueryBuilder) Where(entity ...Entity) ConditionQuery {
 	return where
 }
 
+func (q *deleteQueryBuilder) ForceDelete() DeleteQuery {
+	q.force = true
+	return q
+}
+
 func (q *deleteQueryBuilder) createQueryString() string {
 	result := make([]string, 0)
-	result = append(result, "DELETE FROM", q.escape(q.entity.name), "AS", q.escape(q.entity.alias))
+	if q.isSoftDelete() {
+		result = append(result, "UPDATE", q.escape(q.entity.name), "AS", q.escape(q.entity.alias))
+		result = append(result, "SET", q.escape(DeletedAt), "=", CurrentTimestamp)
+	} else {
+		result = append(result, "DELETE FROM", q.escape(q.entity.name), "AS", q.escape(q.entity.alias))
+	}
 	result = append(result, q.createWheresPart()...)
+	if q.isSoftDelete() {
+		result = append(result, q.createDeletedPart()...)
+	}
 	result = append(result, q.createReturnPart()...)
 	return strings.Join(result, " ") + q.getQueryDivider()
 }
 
+func (q *deleteQueryBuilder) isSoftDelete() bool {
+	return !q.force && q.entity.hasColumn(DeletedAt)
+}
+
+func (q *deleteQueryBuilder) createDeletedPart() []string {
+	result := make([]string, 0)
+	if len(q.wheres) == 0 {
+		result = append(result, "WHERE")
+	} else {
+		result = append(result, "AND")
+	}
+	result = append(result, createDeletedCondition(q.entity, deletedExclude).createQueryString())
+	return result
+}
+
 func (q *deleteQueryBuilder) createColumnsPart() string {
 	result := make([]string, 0)
 	for _, c := range q.entity.columns {
@@ -92,6 +158,7 @@ func (q *deleteQueryBuilder) createWheresPart() []string {
 		if i > 0 {
 			condition = append(condition, "AND")
 		}
+		q.shareArgs(where.queryBuilder)
 		condition = append(condition, where.createQueryString())
 		result = append(result, strings.Join(condition, " "))
 	}
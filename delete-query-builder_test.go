@@ -12,5 +12,7 @@ func TestDelete(t *testing.T) {
 		Delete()
 	q.Where().Column(Id).Equal(1)
 	q.Return(Id)
-	test.Equal(`DELETE FROM "tests" AS "t" WHERE "t"."id" = 1 RETURNING "id";`, q.GetSQL())
+	query, args := q.GetSQLWithArgs()
+	test.Equal(`DELETE FROM "tests" AS "t" WHERE "t"."id" = $1 RETURNING "id";`, query)
+	test.Equal([]any{int64(1)}, args)
 }
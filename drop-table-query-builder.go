@@ -2,13 +2,16 @@ package land
 
 import (
 	"context"
+	"database/sql"
 	"strings"
 )
 
 type DropTableQuery interface {
+	Context(context context.Context) DropTableQuery
 	Cascade() DropTableQuery
 	GetSQL() string
 	Exec()
+	ExecE(ctx context.Context) (sql.Result, error)
 	IfExists() DropTableQuery
 }
 
@@ -28,6 +31,11 @@ func createDropTableQuery(entity *entity) *dropTableQueryBuilder {
 	}
 }
 
+func (q *dropTableQueryBuilder) Context(context context.Context) DropTableQuery {
+	q.context = context
+	return q
+}
+
 func (q *dropTableQueryBuilder) GetSQL() string {
 	return q.createQueryString()
 }
@@ -41,6 +49,10 @@ func (q *dropTableQueryBuilder) Exec() {
 	createQueryManager(q.entity, q.context).setQuery(q.GetSQL()).setQueryType(DropTable).exec()
 }
 
+func (q *dropTableQueryBuilder) ExecE(ctx context.Context) (sql.Result, error) {
+	return createQueryManager(q.entity, ctx).setQuery(q.GetSQL()).setQueryType(DropTable).execE()
+}
+
 func (q *dropTableQueryBuilder) Cascade() DropTableQuery {
 	q.cascade = true
 	return q
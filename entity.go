@@ -1,8 +1,8 @@
 package land
 
 import (
-	"database/sql"
 	"fmt"
+	"strings"
 
 	"github.com/iancoleman/strcase"
 )
@@ -14,6 +14,17 @@ type Entity interface {
 	SetFulltext(entities ...Entity) Entity
 	SetCreatedAt() Entity
 	SetUpdatedAt() Entity
+	SetVersion() Entity
+	SetSoftDelete() Entity
+	OnBeforeInsert(hook InsertHook) Entity
+	OnAfterInsert(hook InsertHook) Entity
+	OnBeforeUpdate(hook UpdateHook) Entity
+	OnAfterUpdate(hook UpdateHook) Entity
+	OnBeforeDelete(hook DeleteHook) Entity
+	OnAfterFind(hook FindHook) Entity
+	HasMany(name string, related Entity, foreignKey string) Entity
+	BelongsTo(name string, related Entity, foreignKey string) Entity
+	ManyToMany(name string, related Entity, pivot Entity, foreignKey, relatedKey string) Entity
 	Select() SelectQuery
 	Insert() InsertQuery
 	Update() UpdateQuery
@@ -35,16 +46,20 @@ type entity struct {
 	name         string
 	columns      []*column
 	fulltext     []*entity
+	hooks        *entityHooks
+	relations    []*relation
 }
 
 func createEntity(land *land, name string) *entity {
 	e := &entity{
-		errorManager: createErrorManager(),
+		errorManager: createErrorManager(land.logger()),
 		errorHandler: createErrorHandler(land),
 		land:         land,
 		name:         name,
 		columns:      make([]*column, 0),
 		fulltext:     make([]*entity, 0),
+		hooks:        createEntityHooks(),
+		relations:    make([]*relation, 0),
 	}
 	e.createIdColumn()
 	return e
@@ -124,6 +139,84 @@ func (e *entity) SetUpdatedAt() Entity {
 	return e
 }
 
+func (e *entity) SetVersion() Entity {
+	e.columns = append(
+		e.columns,
+		&column{name: Version, dataType: Int, options: ColOpts{NotNull: true, Default: 1}},
+	)
+	return e
+}
+
+func (e *entity) SetSoftDelete() Entity {
+	e.columns = append(
+		e.columns,
+		&column{name: DeletedAt, dataType: e.getDateDataType(), options: ColOpts{}},
+	)
+	return e
+}
+
+func (e *entity) OnBeforeInsert(hook InsertHook) Entity {
+	e.hooks.beforeInsert = append(e.hooks.beforeInsert, hook)
+	return e
+}
+
+func (e *entity) OnAfterInsert(hook InsertHook) Entity {
+	e.hooks.afterInsert = append(e.hooks.afterInsert, hook)
+	return e
+}
+
+func (e *entity) OnBeforeUpdate(hook UpdateHook) Entity {
+	e.hooks.beforeUpdate = append(e.hooks.beforeUpdate, hook)
+	return e
+}
+
+func (e *entity) OnAfterUpdate(hook UpdateHook) Entity {
+	e.hooks.afterUpdate = append(e.hooks.afterUpdate, hook)
+	return e
+}
+
+func (e *entity) OnBeforeDelete(hook DeleteHook) Entity {
+	e.hooks.beforeDelete = append(e.hooks.beforeDelete, hook)
+	return e
+}
+
+// OnAfterFind hook is called once per found row with pointer to the row, for GetResult, ScanE and Rows.Scan alike.
+func (e *entity) OnAfterFind(hook FindHook) Entity {
+	e.hooks.afterFind = append(e.hooks.afterFind, hook)
+	return e
+}
+
+func (e *entity) HasMany(name string, related Entity, foreignKey string) Entity {
+	e.relations = append(
+		e.relations,
+		&relation{name: name, relationType: relationHasMany, entity: related.getPtr(), foreignKey: foreignKey},
+	)
+	return e
+}
+
+func (e *entity) BelongsTo(name string, related Entity, foreignKey string) Entity {
+	e.relations = append(
+		e.relations,
+		&relation{name: name, relationType: relationBelongsTo, entity: related.getPtr(), foreignKey: foreignKey},
+	)
+	return e
+}
+
+func (e *entity) ManyToMany(name string, related Entity, pivot Entity, foreignKey, relatedKey string) Entity {
+	e.relations = append(
+		e.relations,
+		&relation{
+			name:         name,
+			relationType: relationManyToMany,
+			entity:       related.getPtr(),
+			pivot:        pivot.getPtr(),
+			foreignKey:   foreignKey,
+			relatedKey:   relatedKey,
+		},
+	)
+	return e
+}
+
 func (e *entity) Column(name string) Safe {
 	return Safe{Value: fmt.Sprintf(`"%s"."%s"`, e.alias, strcase.ToSnake(name))}
 }
@@ -154,8 +247,31 @@ func (e *entity) createIdColumn() Entity {
 	return e
 }
 
-func (e *entity) connection() *sql.DB {
-	return e.land.db.connection
+func (e *entity) hasColumn(name string) bool {
+	for _, c := range e.columns {
+		if c.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *entity) getColumnsKey() string {
+	columns := make([]string, len(e.columns))
+	for i, c := range e.columns {
+		columns[i] = c.name
+	}
+	return e.name + ":" + strings.Join(columns, ",")
+}
+
+func (e *entity) withLand(l *land) *entity {
+	result := *e
+	result.land = l
+	return &result
+}
+
+func (e *entity) connection() executor {
+	return e.land.executor()
 }
 
 func (e *entity) getPtr() *entity {
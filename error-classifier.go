@@ -0,0 +1,94 @@
+package land
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+)
+
+type DBError struct {
+	Kind       error
+	Code       string
+	Message    string
+	Detail     string
+	Schema     string
+	Table      string
+	Column     string
+	Constraint string
+	Err        error
+}
+
+var (
+	ErrUniqueViolation      = errors.New("land: unique violation")
+	ErrForeignKeyViolation  = errors.New("land: foreign key violation")
+	ErrNotNullViolation     = errors.New("land: not null violation")
+	ErrCheckViolation       = errors.New("land: check violation")
+	ErrSerializationFailure = errors.New("land: serialization failure")
+	ErrDeadlock             = errors.New("land: deadlock detected")
+	ErrNoRows               = errors.New("land: no rows in result set")
+)
+
+const (
+	sqlStateUniqueViolation      = "23505"
+	sqlStateForeignKeyViolation  = "23503"
+	sqlStateNotNullViolation     = "23502"
+	sqlStateCheckViolation       = "23514"
+	sqlStateSerializationFailure = "40001"
+	sqlStateDeadlock             = "40P01"
+)
+
+func (e *DBError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *DBError) Unwrap() []error {
+	if e.Kind == nil {
+		return []error{e.Err}
+	}
+	return []error{e.Kind, e.Err}
+}
+
+func classifyError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%w: %w", ErrNoRows, err)
+	}
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return err
+	}
+	return &DBError{
+		Kind:       getErrorKind(string(pqErr.Code)),
+		Code:       string(pqErr.Code),
+		Message:    pqErr.Message,
+		Detail:     pqErr.Detail,
+		Schema:     pqErr.Schema,
+		Table:      pqErr.Table,
+		Column:     pqErr.Column,
+		Constraint: pqErr.Constraint,
+		Err:        err,
+	}
+}
+
+func getErrorKind(code string) error {
+	switch code {
+	case sqlStateUniqueViolation:
+		return ErrUniqueViolation
+	case sqlStateForeignKeyViolation:
+		return ErrForeignKeyViolation
+	case sqlStateNotNullViolation:
+		return ErrNotNullViolation
+	case sqlStateCheckViolation:
+		return ErrCheckViolation
+	case sqlStateSerializationFailure:
+		return ErrSerializationFailure
+	case sqlStateDeadlock:
+		return ErrDeadlock
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,42 @@
+package land
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyUniqueViolation(t *testing.T) {
+	test := assert.New(t)
+	err := classifyError(
+		&pq.Error{Code: "23505", Table: "users", Column: "email", Constraint: "users_email_key"},
+	)
+	test.ErrorIs(err, ErrUniqueViolation)
+	test.NotErrorIs(err, ErrForeignKeyViolation)
+	var dbErr *DBError
+	test.True(errors.As(err, &dbErr))
+	test.Equal("users", dbErr.Table)
+	test.Equal("email", dbErr.Column)
+	test.Equal("users_email_key", dbErr.Constraint)
+	var pqErr *pq.Error
+	test.True(errors.As(err, &pqErr))
+}
+
+func TestClassifyWrappedInQueryError(t *testing.T) {
+	test := assert.New(t)
+	m := createQueryManager(nil, nil).setQueryType(Insert).setQuery("INSERT;")
+	err := m.createQueryError(&pq.Error{Code: "40P01"})
+	test.ErrorIs(err, ErrDeadlock)
+	var queryErr *QueryError
+	test.True(errors.As(err, &queryErr))
+}
+
+func TestClassifyNoRows(t *testing.T) {
+	test := assert.New(t)
+	err := classifyError(sql.ErrNoRows)
+	test.ErrorIs(err, ErrNoRows)
+	test.ErrorIs(err, sql.ErrNoRows)
+}
@@ -1,7 +1,6 @@
 package land
 
 import (
-	"log"
 	"reflect"
 	"strings"
 )
@@ -21,6 +20,10 @@ func Recover(land Land) {
 }
 
 func (e *errorHandler) createErrorMessage(err error, msg string, query string) {
+	e.land.logger().Println(createErrorMessage(err, msg, query))
+}
+
+func createErrorMessage(err error, msg string, query string) string {
 	formatSlice := make([]string, 0)
 	if err != nil {
 		formatSlice = append(formatSlice, "[ERROR]: "+err.Error())
@@ -32,12 +35,7 @@ func (e *errorHandler) createErrorMessage(err error, msg string, query string) {
 		formatSlice = append(formatSlice, "[QUERY]: "+query)
 	}
 	formatSlice = append(formatSlice, "----------")
-	result := "\n" + strings.Join(formatSlice, "\n")
-	if e.land.migration {
-		log.Fatalln(result)
-		return
-	}
-	log.Println(result)
+	return "\n" + strings.Join(formatSlice, "\n")
 }
 
 func (e *errorHandler) recover() {
@@ -2,8 +2,6 @@ package land
 
 import (
 	"errors"
-
-	"util/dd"
 )
 
 type ErrorManager interface {
@@ -13,11 +11,13 @@ type ErrorManager interface {
 }
 
 type errorManager struct {
+	logger Logger
 	errors []Error
 }
 
-func createErrorManager() *errorManager {
+func createErrorManager(logger Logger) *errorManager {
 	return &errorManager{
+		logger: logger,
 		errors: make([]Error, 0),
 	}
 }
@@ -42,7 +42,7 @@ func (m *errorManager) check(err error, query string) {
 		return
 	}
 	e := Error{Error: err, Query: query}
-	dd.Print(e)
+	m.logger.Println(createErrorMessage(e.Error, e.Message, e.Query))
 	m.errors = append(m.errors, e)
 	panic(e)
 }
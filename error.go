@@ -1,7 +1,35 @@
 package land
 
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
 type Error struct {
 	Error   error
 	Query   string
 	Message string
 }
+
+type QueryError struct {
+	Err       error
+	SQL       string
+	Args      []any
+	QueryType string
+}
+
+var (
+	ErrInvalidDest      = errors.New("land: destination must be a non-nil pointer")
+	ErrStaleObject      = errors.New("land: stale object, row was changed or deleted")
+	ErrMissingKeyColumn = errors.New("land: rows are missing key column")
+	ErrValueType        = errors.New("land: value type does not match column type")
+)
+
+func (e *QueryError) Error() string {
+	return fmt.Sprintf("land: %s failed: %v", strings.ToLower(e.QueryType), e.Err)
+}
+
+func (e *QueryError) Unwrap() error {
+	return e.Err
+}
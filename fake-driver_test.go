@@ -0,0 +1,183 @@
+package land
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type testFakeDB struct {
+	mutex        sync.Mutex
+	queries      []string
+	results      map[string]testFakeResult
+	rowsAffected int64
+	commitErr    error
+}
+
+type testFakeResult struct {
+	columns []string
+	types   []string
+	rows    [][]driver.Value
+}
+
+type testFakeConnector struct {
+	db *testFakeDB
+}
+
+type testFakeConn struct {
+	db *testFakeDB
+}
+
+type testFakeTx struct {
+	db *testFakeDB
+}
+
+type testFakeRows struct {
+	result testFakeResult
+	index  int
+}
+
+func testFakeLand(t *testing.T) (Land, *testFakeDB) {
+	fake := &testFakeDB{results: make(map[string]testFakeResult)}
+	connection := sql.OpenDB(testFakeConnector{db: fake})
+	t.Cleanup(func() {
+		_ = connection.Close()
+	})
+	return &land{
+		db:       &db{connection: connection, connector: Connect().Postgres().getPtr()},
+		entities: make([]*entity, 0),
+		config:   Config{},
+	}, fake
+}
+
+func (d *testFakeDB) setResult(table string, columns []string, rows ...[]driver.Value) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.results[table] = testFakeResult{columns: columns, rows: rows}
+}
+
+func (d *testFakeDB) setColumnTypes(table string, types ...string) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	result := d.results[table]
+	result.types = types
+	d.results[table] = result
+}
+
+func (d *testFakeDB) setRowsAffected(rowsAffected int64) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.rowsAffected = rowsAffected
+}
+
+func (d *testFakeDB) setCommitError(err error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.commitErr = err
+}
+
+func (d *testFakeDB) getQueries() []string {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	return append([]string{}, d.queries...)
+}
+
+func (d *testFakeDB) query(query string) testFakeResult {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.queries = append(d.queries, query)
+	for table, result := range d.results {
+		if strings.Contains(query, `FROM "`+table+`"`) || strings.HasPrefix(query, `UPDATE "`+table+`"`) {
+			return result
+		}
+	}
+	return testFakeResult{}
+}
+
+func (c testFakeConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	return testFakeConn{db: c.db}, nil
+}
+
+func (c testFakeConnector) Driver() driver.Driver {
+	return c
+}
+
+func (c testFakeConnector) Open(name string) (driver.Conn, error) {
+	return testFakeConn{db: c.db}, nil
+}
+
+func (testFakeConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, driver.ErrSkip
+}
+
+func (testFakeConn) Close() error {
+	return nil
+}
+
+func (c testFakeConn) Begin() (driver.Tx, error) {
+	return testFakeTx{db: c.db}, nil
+}
+
+func (t testFakeTx) Commit() error {
+	t.db.mutex.Lock()
+	defer t.db.mutex.Unlock()
+	return t.db.commitErr
+}
+
+func (testFakeTx) Rollback() error {
+	return nil
+}
+
+func (c testFakeConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return &testFakeRows{result: c.db.query(query)}, nil
+}
+
+func (c testFakeConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.db.query(query)
+	c.db.mutex.Lock()
+	defer c.db.mutex.Unlock()
+	return driver.RowsAffected(c.db.rowsAffected), nil
+}
+
+func (r *testFakeRows) Columns() []string {
+	return r.result.columns
+}
+
+func (r *testFakeRows) ColumnTypeDatabaseTypeName(index int) string {
+	if index < len(r.result.types) {
+		return r.result.types[index]
+	}
+	if len(r.result.rows) == 0 {
+		return "VARCHAR"
+	}
+	switch r.result.rows[0][index].(type) {
+	case int64:
+		return "INT8"
+	case float64:
+		return "FLOAT8"
+	case bool:
+		return "BOOL"
+	case time.Time:
+		return "TIMESTAMP"
+	default:
+		return "VARCHAR"
+	}
+}
+
+func (r *testFakeRows) Close() error {
+	return nil
+}
+
+func (r *testFakeRows) Next(dest []driver.Value) error {
+	if r.index >= len(r.result.rows) {
+		return io.EOF
+	}
+	copy(dest, r.result.rows[r.index])
+	r.index++
+	return nil
+}
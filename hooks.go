@@ -0,0 +1,183 @@
+package land
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+type InsertHook func(ctx context.Context, q InsertQuery, value any) error
+
+type UpdateHook func(ctx context.Context, q UpdateQuery, value any) error
+
+type DeleteHook func(ctx context.Context, q DeleteQuery) error
+
+type FindHook func(ctx context.Context, q SelectQuery, value any) error
+
+type BeforeInsertHook interface {
+	BeforeInsert(ctx context.Context) error
+}
+
+type AfterInsertHook interface {
+	AfterInsert(ctx context.Context) error
+}
+
+type BeforeUpdateHook interface {
+	BeforeUpdate(ctx context.Context) error
+}
+
+type AfterUpdateHook interface {
+	AfterUpdate(ctx context.Context) error
+}
+
+type AfterFindHook interface {
+	AfterFind(ctx context.Context) error
+}
+
+type entityHooks struct {
+	beforeInsert []InsertHook
+	afterInsert  []InsertHook
+	beforeUpdate []UpdateHook
+	afterUpdate  []UpdateHook
+	beforeDelete []DeleteHook
+	afterFind    []FindHook
+}
+
+func createEntityHooks() *entityHooks {
+	return &entityHooks{
+		beforeInsert: make([]InsertHook, 0),
+		afterInsert:  make([]InsertHook, 0),
+		beforeUpdate: make([]UpdateHook, 0),
+		afterUpdate:  make([]UpdateHook, 0),
+		beforeDelete: make([]DeleteHook, 0),
+		afterFind:    make([]FindHook, 0),
+	}
+}
+
+func (e *entity) runBeforeInsert(ctx context.Context, q InsertQuery, value any, rows []ref) error {
+	for _, row := range rows {
+		if err := callModelHook(e, row.v, func(m BeforeInsertHook) error { return m.BeforeInsert(ctx) }); err != nil {
+			return err
+		}
+	}
+	for _, hook := range e.hooks.beforeInsert {
+		if err := hook(ctx, q, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *entity) runAfterInsert(ctx context.Context, q InsertQuery, value any, rows []ref) error {
+	for _, row := range rows {
+		if err := callModelHook(e, row.v, func(m AfterInsertHook) error { return m.AfterInsert(ctx) }); err != nil {
+			return err
+		}
+	}
+	for _, hook := range e.hooks.afterInsert {
+		if err := hook(ctx, q, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *entity) runBeforeUpdate(ctx context.Context, q UpdateQuery, value any, rows []ref) error {
+	for _, row := range rows {
+		if err := callModelHook(e, row.v, func(m BeforeUpdateHook) error { return m.BeforeUpdate(ctx) }); err != nil {
+			return err
+		}
+	}
+	for _, hook := range e.hooks.beforeUpdate {
+		if err := hook(ctx, q, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *entity) runAfterUpdate(ctx context.Context, q UpdateQuery, value any, rows []ref) error {
+	for _, row := range rows {
+		if err := callModelHook(e, row.v, func(m AfterUpdateHook) error { return m.AfterUpdate(ctx) }); err != nil {
+			return err
+		}
+	}
+	for _, hook := range e.hooks.afterUpdate {
+		if err := hook(ctx, q, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *entity) runBeforeDelete(ctx context.Context, q DeleteQuery) error {
+	for _, hook := range e.hooks.beforeDelete {
+		if err := hook(ctx, q); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *entity) runAfterFind(ctx context.Context, q SelectQuery, dest any) error {
+	v := reflect.ValueOf(dest)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	models := []reflect.Value{v}
+	if v.Kind() == reflect.Slice {
+		models = make([]reflect.Value, v.Len())
+		for i := range models {
+			models[i] = v.Index(i)
+		}
+	}
+	for _, model := range models {
+		if err := callModelHook(e, model, func(m AfterFindHook) error { return m.AfterFind(ctx) }); err != nil {
+			return err
+		}
+		row := model.Interface()
+		if model.CanAddr() {
+			row = model.Addr().Interface()
+		}
+		for _, hook := range e.hooks.afterFind {
+			if err := hook(ctx, q, row); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (e *entity) hasFindHooks(dest any) bool {
+	if len(e.hooks.afterFind) > 0 {
+		return true
+	}
+	t := reflect.TypeOf(dest)
+	for t != nil && (t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice) {
+		t = t.Elem()
+	}
+	return t != nil && (t.Implements(afterFindHookType) || reflect.PointerTo(t).Implements(afterFindHookType))
+}
+
+var (
+	afterFindHookType = reflect.TypeOf((*AfterFindHook)(nil)).Elem()
+)
+
+func callModelHook[T any](e *entity, v reflect.Value, fn func(model T) error) error {
+	if !v.IsValid() || (v.Kind() == reflect.Ptr && v.IsNil()) {
+		return nil
+	}
+	if v.CanAddr() {
+		if model, ok := v.Addr().Interface().(T); ok {
+			return fn(model)
+		}
+	}
+	if model, ok := v.Interface().(T); ok {
+		return fn(model)
+	}
+	hookType := reflect.TypeOf((*T)(nil)).Elem()
+	if v.Kind() != reflect.Ptr && reflect.PointerTo(v.Type()).Implements(hookType) {
+		e.land.logger().Println(fmt.Sprintf("land: %s of %s skipped, pass model by pointer", hookType.Name(), v.Type()))
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package land
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testHookModel struct {
+	Name     string
+	Lastname string
+	found    bool
+}
+
+func (m *testHookModel) BeforeInsert(ctx context.Context) error {
+	m.Lastname = strings.ToUpper(m.Name)
+	return nil
+}
+
+func (m *testHookModel) AfterFind(ctx context.Context) error {
+	m.found = true
+	return nil
+}
+
+func TestHooksBeforeInsert(t *testing.T) {
+	test := assert.New(t)
+	var query string
+	l, _ := testFakeLand(t)
+	e := testEntity(l).OnBeforeInsert(func(ctx context.Context, q InsertQuery, value any) error {
+		query = q.GetSQL()
+		return nil
+	})
+	model := &testHookModel{Name: "Dominik"}
+	_, err := e.Insert().SetValues(model).ExecE(context.Background())
+	test.NoError(err)
+	test.Equal("DOMINIK", model.Lastname)
+	test.Contains(query, `VALUES ($1,$2,`)
+}
+
+type testHookLogger struct {
+	messages []string
+}
+
+func (l *testHookLogger) Println(v ...any) {
+	l.messages = append(l.messages, fmt.Sprint(v...))
+}
+
+func TestHooksByValue(t *testing.T) {
+	test := assert.New(t)
+	l, _ := testFakeLand(t)
+	logger := &testHookLogger{}
+	l.getPtr().config.Logger = logger
+	model := testHookModel{Name: "Dominik"}
+	_, err := testEntity(l).Insert().SetValues(model).ExecE(context.Background())
+	test.NoError(err)
+	test.Empty(model.Lastname)
+	test.Equal(
+		[]string{"land: BeforeInsertHook of land.testHookModel skipped, pass model by pointer"},
+		logger.messages,
+	)
+}
+
+func TestHooksAbort(t *testing.T) {
+	test := assert.New(t)
+	errHook := errors.New("hook failed")
+	after := false
+	l, _ := testFakeLand(t)
+	e := testEntity(l).
+		OnBeforeUpdate(func(ctx context.Context, q UpdateQuery, value any) error {
+			return errHook
+		}).
+		OnAfterUpdate(func(ctx context.Context, q UpdateQuery, value any) error {
+			after = true
+			return nil
+		})
+	_, err := e.Update().SetValues(testModel{Name: "Dominik"}).ExecE(context.Background())
+	test.ErrorIs(err, errHook)
+	test.False(after)
+	test.Panics(func() {
+		e.Update().SetValues(testModel{Name: "Dominik"}).Exec()
+	})
+}
+
+func TestHooksRollback(t *testing.T) {
+	test := assert.New(t)
+	errHook := errors.New("hook failed")
+	l, fake := testFakeLand(t)
+	var transaction Land
+	err := l.InTransaction(context.Background(), func(tx Land) error {
+		transaction = tx
+		_, err := testEntity(tx).
+			OnBeforeDelete(func(ctx context.Context, q DeleteQuery) error {
+				return errHook
+			}).
+			Delete().
+			ExecE(context.Background())
+		test.True(tx.getPtr().transaction.isActive())
+		_, queryErr := testEntity(tx).Delete().ExecE(context.Background())
+		test.NoError(queryErr)
+		return err
+	})
+	test.ErrorIs(err, errHook)
+	test.NotContains(err.Error(), "rollback failed")
+	test.ErrorIs(transaction.getPtr().transaction.check(), ErrTxDone)
+	test.Len(fake.getQueries(), 1)
+}
+
+func TestHooksAfterFind(t *testing.T) {
+	test := assert.New(t)
+	calls := 0
+	l, fake := testFakeLand(t)
+	fake.setResult(testEntityName, []string{testName, testLastname}, []driver.Value{"Dominik", "Linduska"}, []driver.Value{"Jan", "Novak"})
+	e := testEntity(l).OnAfterFind(func(ctx context.Context, q SelectQuery, value any) error {
+		calls++
+		test.IsType(&testHookModel{}, value)
+		return nil
+	})
+	result := make([]testHookModel, 0)
+	test.NoError(e.Select().ScanE(context.Background(), &result))
+	test.Len(result, 2)
+	test.True(result[0].found)
+	test.Equal(2, calls)
+	rows, err := e.Select().Rows(context.Background())
+	test.NoError(err)
+	defer rows.Close()
+	var row testHookModel
+	test.True(rows.Next())
+	test.NoError(rows.Scan(&row))
+	test.True(row.found)
+	test.Equal(3, calls)
+}
@@ -2,29 +2,43 @@ package land
 
 import (
 	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
 	"reflect"
 	"strings"
-	
-	"github.com/iancoleman/strcase"
+	"time"
+
+	"github.com/lib/pq"
 )
 
 type InsertQuery interface {
+	Context(context context.Context) InsertQuery
 	SetValues(value any) InsertQuery
 	CustomId() InsertQuery
 	CustomTimestamp() InsertQuery
 	GetSQL() string
+	GetSQLWithArgs() (string, []any)
 	Exec()
+	ExecE(ctx context.Context) (sql.Result, error)
 	GetResult(dest any)
+	ScanE(ctx context.Context, dest any) error
 	SetVectors(values ...any) InsertQuery
 	Return(columns ...string) InsertQuery
+	Copy(ctx context.Context, rows any) (int64, error)
+	OnConflict(columns ...string) ConflictQuery
+	OnConstraint(name string) ConflictQuery
 }
 
 type insertQueryBuilder struct {
 	*queryBuilder
 	entity          *entity
 	context         context.Context
-	data            ref
+	value           any
+	rows            []ref
+	bulk            bool
 	vectors         string
+	conflict        *conflictQueryBuilder
 	returns         []string
 	isReturn        bool
 	customId        bool
@@ -36,12 +50,35 @@ func createInsertQuery(entity *entity) *insertQueryBuilder {
 		queryBuilder: createQueryBuilder().setQueryType(Insert),
 		entity:       entity,
 		context:      context.Background(),
+		rows:         make([]ref, 0),
 		returns:      make([]string, 0),
 	}
 }
 
+type insertStatement struct {
+	query  string
+	args   []any
+	offset int
+	count  int
+}
+
+const (
+	maxQueryArgs = 65535
+)
+
+func (q *insertQueryBuilder) Context(context context.Context) InsertQuery {
+	q.context = context
+	return q
+}
+
 func (q *insertQueryBuilder) GetSQL() string {
-	return q.createQueryString()
+	query, _ := q.GetSQLWithArgs()
+	return query
+}
+
+func (q *insertQueryBuilder) GetSQLWithArgs() (string, []any) {
+	q.resetArgs()
+	return q.createQueryString(), q.getArgs()
 }
 
 func (q *insertQueryBuilder) CustomId() InsertQuery {
@@ -55,23 +92,138 @@ func (q *insertQueryBuilder) CustomTimestamp() InsertQuery {
 }
 
 func (q *insertQueryBuilder) Exec() {
-	createQueryManager(q.entity, q.context).setQuery(q.GetSQL()).setQueryType(Insert).exec()
+	q.entity.errorManager.check(q.checkConflict(), "")
+	q.entity.errorManager.check(q.entity.runBeforeInsert(q.context, q, q.value, q.rows), "")
+	if q.bulk {
+		if _, err := q.execBulk(q.context); err != nil {
+			q.entity.errorManager.check(err, q.GetSQL())
+		}
+	} else {
+		query, args := q.GetSQLWithArgs()
+		createQueryManager(q.entity, q.context).setQuery(query).setArgs(args).setQueryType(Insert).exec()
+	}
+	q.entity.errorManager.check(q.entity.runAfterInsert(q.context, q, q.value, q.rows), "")
 }
 
 func (q *insertQueryBuilder) GetResult(dest any) {
-	createQueryManager(q.entity, q.context).setQuery(q.GetSQL()).setQueryType(Insert).setDest(dest).getResult()
+	q.entity.errorManager.check(q.checkConflict(), "")
+	q.entity.errorManager.check(q.entity.runBeforeInsert(q.context, q, q.value, q.rows), "")
+	if q.bulk {
+		if err := q.scanBulk(q.context, dest); err != nil {
+			q.entity.errorManager.check(err, q.GetSQL())
+		}
+	} else {
+		query, args := q.GetSQLWithArgs()
+		createQueryManager(q.entity, q.context).setQuery(query).setArgs(args).setQueryType(Insert).setDest(dest).getResult()
+	}
+	q.entity.errorManager.check(q.entity.runAfterInsert(q.context, q, q.value, q.rows), "")
+}
+
+func (q *insertQueryBuilder) ExecE(ctx context.Context) (sql.Result, error) {
+	if err := q.checkConflict(); err != nil {
+		return nil, err
+	}
+	if err := q.entity.runBeforeInsert(ctx, q, q.value, q.rows); err != nil {
+		return nil, err
+	}
+	var result sql.Result
+	var err error
+	if q.bulk {
+		result, err = q.execBulk(ctx)
+	} else {
+		query, args := q.GetSQLWithArgs()
+		result, err = createQueryManager(q.entity, ctx).setQuery(query).setArgs(args).setQueryType(Insert).execE()
+	}
+	if err != nil {
+		return result, err
+	}
+	return result, q.entity.runAfterInsert(ctx, q, q.value, q.rows)
+}
+
+func (q *insertQueryBuilder) ScanE(ctx context.Context, dest any) error {
+	if err := q.checkConflict(); err != nil {
+		return err
+	}
+	if err := q.entity.runBeforeInsert(ctx, q, q.value, q.rows); err != nil {
+		return err
+	}
+	var err error
+	if q.bulk {
+		err = q.scanBulk(ctx, dest)
+	} else {
+		query, args := q.GetSQLWithArgs()
+		err = createQueryManager(q.entity, ctx).setQuery(query).setArgs(args).setQueryType(Insert).setDest(dest).getResultE()
+	}
+	if err != nil {
+		return err
+	}
+	return q.entity.runAfterInsert(ctx, q, q.value, q.rows)
 }
 
 func (q *insertQueryBuilder) SetValues(data any) InsertQuery {
-	q.data.t = reflect.TypeOf(data)
-	q.data.v = reflect.ValueOf(data)
-	q.data.kind = q.data.v.Kind()
-	if q.data.v.Kind() == reflect.Ptr {
-		q.data.v = q.data.v.Elem()
+	v := reflect.ValueOf(data)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	q.value = data
+	q.rows = make([]ref, 0)
+	q.bulk = v.Kind() == reflect.Slice
+	if !q.bulk {
+		q.rows = append(q.rows, createDataRef(v))
+		return q
+	}
+	for i := 0; i < v.Len(); i++ {
+		q.rows = append(q.rows, createDataRef(v.Index(i)))
 	}
 	return q
 }
 
+func (q *insertQueryBuilder) Copy(ctx context.Context, rows any) (int64, error) {
+	q.SetValues(rows)
+	if err := q.entity.runBeforeInsert(ctx, q, q.value, q.rows); err != nil {
+		return 0, err
+	}
+	columns := q.getCopyColumns()
+	names := make([]string, len(columns))
+	for i, c := range columns {
+		names[i] = c.name
+	}
+	query := pq.CopyIn(q.entity.name, names...)
+	var result int64
+	err := q.entity.land.InTransaction(ctx, func(tx Land) error {
+		stmt, err := tx.getPtr().transaction.tx.PrepareContext(ctx, query)
+		if err != nil {
+			return err
+		}
+		for _, row := range q.rows {
+			if _, err := stmt.ExecContext(ctx, q.createCopyValues(row, columns)...); err != nil {
+				return errors.Join(err, stmt.Close())
+			}
+		}
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			return errors.Join(err, stmt.Close())
+		}
+		result = int64(len(q.rows))
+		return stmt.Close()
+	})
+	if err != nil {
+		return 0, createQueryManager(q.entity, ctx).setQuery(query).setQueryType(Insert).createQueryError(err)
+	}
+	return result, q.entity.runAfterInsert(ctx, q, q.value, q.rows)
+}
+
+func (q *insertQueryBuilder) OnConflict(columns ...string) ConflictQuery {
+	q.conflict = createConflictQuery(q.entity)
+	q.conflict.columns = columns
+	return q.conflict
+}
+
+func (q *insertQueryBuilder) OnConstraint(name string) ConflictQuery {
+	q.conflict = createConflictQuery(q.entity)
+	q.conflict.constraint = name
+	return q.conflict
+}
+
 func (q *insertQueryBuilder) Return(columns ...string) InsertQuery {
 	q.returns = append(q.returns, columns...)
 	q.isReturn = true
@@ -83,74 +235,273 @@ func (q *insertQueryBuilder) SetVectors(values ...any) InsertQuery {
 	return q
 }
 
+func (q *insertQueryBuilder) checkConflict() error {
+	if q.conflict == nil {
+		return nil
+	}
+	return q.conflict.validate(q.getColumns())
+}
+
+func (q *insertQueryBuilder) execBulk(ctx context.Context) (sql.Result, error) {
+	var result int64
+	err := q.runStatements(ctx, func(e *entity, statement insertStatement) error {
+		m := createQueryManager(e, ctx).setQuery(statement.query).setArgs(statement.args).setQueryType(Insert)
+		if !q.isReturn {
+			r, err := m.execE()
+			if err != nil {
+				return err
+			}
+			count, err := r.RowsAffected()
+			result += count
+			return err
+		}
+		count, err := q.scanReturned(m, statement)
+		result += count
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return driver.RowsAffected(result), nil
+}
+
+func (q *insertQueryBuilder) scanBulk(ctx context.Context, dest any) error {
+	return q.runStatements(ctx, func(e *entity, statement insertStatement) error {
+		return createQueryManager(e, ctx).setQuery(statement.query).setArgs(statement.args).setQueryType(Insert).setDest(dest).getResultE()
+	})
+}
+
+func (q *insertQueryBuilder) scanReturned(m *queryManager, statement insertStatement) (result int64, err error) {
+	rows, err := m.getRows(0)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		err = errors.Join(err, rows.Close())
+	}()
+	for rows.Next() {
+		i := statement.offset + int(result)
+		result++
+		if q.conflict != nil && q.conflict.action == conflictDoNothing {
+			continue
+		}
+		if i >= len(q.rows) || !q.rows[i].v.CanAddr() || q.rows[i].v.Kind() != reflect.Struct {
+			continue
+		}
+		if err := rows.Scan(q.rows[i].v.Addr().Interface()); err != nil {
+			return result, err
+		}
+	}
+	return result, rows.Err()
+}
+
+func (q *insertQueryBuilder) runStatements(ctx context.Context, fn func(e *entity, statement insertStatement) error) error {
+	statements := q.createStatements()
+	if len(statements) == 1 {
+		return fn(q.entity, statements[0])
+	}
+	return q.entity.land.InTransaction(ctx, func(tx Land) error {
+		e := q.entity.withLand(tx.getPtr())
+		for _, statement := range statements {
+			if err := fn(e, statement); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 func (q *insertQueryBuilder) createQueryString() string {
+	columns := q.getColumns()
+	values := make([]string, len(q.rows))
+	for i, row := range q.rows {
+		values[i] = q.createRowValues(row, columns)
+	}
+	if len(values) == 0 {
+		values = append(values, "()")
+	}
+	return q.createStatementString(columns, values)
+}
+
+func (q *insertQueryBuilder) createStatementString(columns []*column, values []string) string {
+	columnsSql := make([]string, len(columns))
+	for i, c := range columns {
+		columnsSql[i] = q.escape(c.name)
+	}
 	result := make([]string, 0)
 	result = append(result, "INSERT", "INTO", q.escape(q.entity.name))
-	result = append(result, "("+q.createColumnsPart()+")")
+	if q.conflict != nil && len(q.entity.alias) > 0 {
+		result = append(result, "AS", q.escape(q.entity.alias))
+	}
+	result = append(result, "("+strings.Join(columnsSql, q.getColumnsDivider())+")")
 	result = append(result, "VALUES")
-	result = append(result, "("+q.createValuesPart()+")")
+	result = append(result, strings.Join(values, q.getColumnsDivider()))
+	if q.conflict != nil {
+		q.shareArgs(q.conflict.queryBuilder)
+		result = append(result, q.conflict.createQueryString(columns))
+	}
 	result = append(result, q.createReturnPart()...)
 	return strings.Join(result, " ") + q.getQueryDivider()
 }
 
-func (q *insertQueryBuilder) createColumnsPart() string {
-	result := make([]string, 0)
-	for _, c := range q.entity.columns {
-		if !q.customId && c.name == Id {
-			continue
+func (q *insertQueryBuilder) createStatements() []insertStatement {
+	columns := q.getColumns()
+	result := make([]insertStatement, 0)
+	values := make([]string, 0)
+	offset := 0
+	q.resetArgs()
+	for i, row := range q.rows {
+		argsCount := len(q.getArgs())
+		rowValues := q.createRowValues(row, columns)
+		if len(q.getArgs()) > maxQueryArgs && len(values) > 0 {
+			q.args.values = q.args.values[:argsCount]
+			result = append(result, q.createStatement(columns, values, offset))
+			offset = i
+			values = make([]string, 0)
+			q.resetArgs()
+			rowValues = q.createRowValues(row, columns)
 		}
-		result = append(result, q.escape(c.name))
+		values = append(values, rowValues)
+	}
+	if len(values) > 0 {
+		result = append(result, q.createStatement(columns, values, offset))
 	}
-	return strings.Join(result, q.getColumnsDivider())
+	return result
 }
 
-func (q *insertQueryBuilder) createValuesPart() string {
-	result := make([]string, 0)
+func (q *insertQueryBuilder) createStatement(columns []*column, values []string, offset int) insertStatement {
+	return insertStatement{
+		query:  q.createStatementString(columns, values),
+		args:   q.getArgs(),
+		offset: offset,
+		count:  len(values),
+	}
+}
+
+func (q *insertQueryBuilder) getColumns() []*column {
+	result := make([]*column, 0)
 	for _, c := range q.entity.columns {
-		if !q.data.v.IsValid() {
-			continue
-		}
 		if !q.customId && c.name == Id {
 			continue
 		}
-		if !q.customTimestamp && c.name == CreatedAt {
-			result = append(result, CurrentTimestamp)
-			continue
-		}
-		if !q.customTimestamp && c.name == UpdatedAt {
-			result = append(result, CurrentTimestamp)
-			continue
-		}
-		if c.name == Vectors {
-			if len(q.vectors) == 0 {
-				result = append(result, createTSVectors(""))
-			}
-			if len(q.vectors) > 0 {
-				result = append(result, q.vectors)
+		for _, row := range q.rows {
+			if q.isColumnIncluded(c, row) {
+				result = append(result, c)
+				break
 			}
+		}
+	}
+	return result
+}
+
+func (q *insertQueryBuilder) createRowValues(row ref, columns []*column) string {
+	values := make([]string, len(columns))
+	for i, c := range columns {
+		if !q.isColumnIncluded(c, row) {
+			values[i] = "DEFAULT"
 			continue
 		}
-		var field reflect.Value
-		switch q.data.kind {
-		case reflect.Map:
-			field = q.getMapValue(q.data.v, c.name)
-		case reflect.Struct:
-			field = q.data.v.FieldByName(strcase.ToCamel(c.name))
+		values[i] = q.createColumnValue(c, row)
+	}
+	return "(" + strings.Join(values, q.getColumnsDivider()) + ")"
+}
+
+func (q *insertQueryBuilder) isColumnIncluded(c *column, data ref) bool {
+	if !data.v.IsValid() {
+		return false
+	}
+	if !q.customTimestamp && (c.name == CreatedAt || c.name == UpdatedAt) {
+		return true
+	}
+	if c.name == Vectors || c.name == Version {
+		return true
+	}
+	field, structField := q.getDataField(data, c)
+	return field.IsValid() && !q.shouldSkipField(field, structField)
+}
+
+func (q *insertQueryBuilder) createColumnValue(c *column, data ref) string {
+	if !q.customTimestamp && (c.name == CreatedAt || c.name == UpdatedAt) {
+		return CurrentTimestamp
+	}
+	if c.name == Version {
+		return q.bind(1)
+	}
+	if c.name == Vectors {
+		return q.createTSVectorsValue(q.vectors)
+	}
+	field, _ := q.getDataField(data, c)
+	if isNullValue(field) && !c.options.NotNull {
+		return "NULL"
+	}
+	if isNullValue(field) && c.options.NotNull {
+		return q.createValue(c, q.createDefaultValue(c, reflect.ValueOf(c.options.Default)))
+	}
+	return q.createValue(c, field)
+}
+
+func (q *insertQueryBuilder) getCopyColumns() []*column {
+	result := make([]*column, 0)
+	for _, c := range q.getColumns() {
+		if c.name != Vectors {
+			result = append(result, c)
 		}
-		if !field.IsValid() {
+	}
+	return result
+}
+
+func (q *insertQueryBuilder) createCopyValues(row ref, columns []*column) []any {
+	result := make([]any, len(columns))
+	for i, c := range columns {
+		if !q.customTimestamp && (c.name == CreatedAt || c.name == UpdatedAt) {
+			result[i] = time.Now()
 			continue
 		}
-		if field.IsZero() && !c.options.NotNull {
-			result = append(result, "NULL")
+		if c.name == Version {
+			result[i] = 1
 			continue
 		}
-		if field.IsZero() && c.options.NotNull {
-			result = append(result, q.createValue(c, q.createDefaultValue(c, reflect.ValueOf(c.options.Default))))
+		field, structField := q.getDataField(row, c)
+		if !field.IsValid() || q.shouldSkipField(field, structField) || isNullValue(field) {
+			result[i] = q.createCopyDefaultValue(c)
 			continue
 		}
-		result = append(result, q.createValue(c, field))
+		result[i] = q.createCopyValue(c, field)
+	}
+	return result
+}
+
+func (q *insertQueryBuilder) createCopyValue(c *column, value reflect.Value) any {
+	if value.Kind() == reflect.Interface {
+		value = reflect.ValueOf(value.Interface())
+	}
+	if value.Type().Implements(valuerType) {
+		return value.Interface()
+	}
+	if codec := getTypeCodec(c.dataType); codec != nil {
+		return codecValue{codec: codec, value: value.Interface()}
+	}
+	if value.Kind() == reflect.Ptr {
+		value = value.Elem()
+	}
+	if isJsonType(c.dataType) {
+		return jsonValue{value: value.Interface()}
+	}
+	if isArrayType(c.dataType) || (value.Kind() == reflect.Slice && value.Type().Elem().Kind() != reflect.Uint8) {
+		return createArrayValue(value.Interface())
+	}
+	return value.Interface()
+}
+
+func (q *insertQueryBuilder) createCopyDefaultValue(c *column) any {
+	if !c.options.NotNull {
+		return nil
+	}
+	value := q.createDefaultValue(c, reflect.ValueOf(c.options.Default))
+	if value.Kind() == reflect.String && value.String() == CurrentTimestamp {
+		return time.Now()
 	}
-	return strings.Join(result, q.getColumnsDivider())
+	return value.Interface()
 }
 
 func (q *insertQueryBuilder) createReturnPart() []string {
@@ -1,6 +1,7 @@
 package land
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -18,8 +19,128 @@ func TestInsert(t *testing.T) {
 		).
 		SetVectors("Dominik", "Linduska")
 	q.Return(Id, "name", "lastname")
+	query, args := q.GetSQLWithArgs()
 	test.Equal(
-		`INSERT INTO "tests" ("name","lastname","active","vectors","created_at","updated_at") VALUES ('Dominik','Linduska',false,to_tsvector('dominik linduska'),CURRENT_TIMESTAMP,CURRENT_TIMESTAMP) RETURNING "id","name","lastname";`,
-		q.GetSQL(),
+		`INSERT INTO "tests" ("name","lastname","active","vectors","created_at","updated_at") VALUES ($1,$2,$3,to_tsvector($4),CURRENT_TIMESTAMP,CURRENT_TIMESTAMP) RETURNING "id","name","lastname";`,
+		query,
 	)
+	test.Equal([]any{"Dominik", "Linduska", false, "dominik linduska"}, args)
+}
+
+func TestInsertQuotedValue(t *testing.T) {
+	test := assert.New(t)
+	q := testEntity(testCreatePostgresInstance()).
+		Insert().
+		SetValues(
+			testModel{
+				Name:     "Conan",
+				Lastname: "O'Brien",
+				Active:   true,
+			},
+		)
+	query, args := q.GetSQLWithArgs()
+	test.Equal(
+		`INSERT INTO "tests" ("name","lastname","active","vectors","created_at","updated_at") VALUES ($1,$2,$3,to_tsvector(''),CURRENT_TIMESTAMP,CURRENT_TIMESTAMP);`,
+		query,
+	)
+	test.Equal([]any{"Conan", "O'Brien", true}, args)
+}
+
+func TestInsertContext(t *testing.T) {
+	test := assert.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	q := testEntity(testCreatePostgresInstance()).Insert().Context(ctx)
+	test.Equal(ctx, q.(*insertQueryBuilder).context)
+}
+
+func TestInsertContextCanceled(t *testing.T) {
+	test := assert.New(t)
+	l, fake := testFakeLand(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := testEntity(l).Insert().SetValues(testModel{Name: "Dominik"}).ExecE(ctx)
+	test.ErrorIs(err, context.Canceled)
+	var result []testModel
+	err = testEntity(l).Insert().SetValues(testModel{Name: "Dominik"}).Return(Id).ScanE(ctx, &result)
+	test.ErrorIs(err, context.Canceled)
+	test.Empty(fake.getQueries())
+}
+
+func TestInsertSlice(t *testing.T) {
+	test := assert.New(t)
+	q := testEntity(testCreatePostgresInstance()).
+		Insert().
+		SetValues(
+			[]*testModel{
+				{Name: "Dominik", Lastname: "Linduska"},
+				{Name: "Conan", Lastname: "O'Brien", Active: true},
+			},
+		).
+		Return(Id)
+	query, args := q.GetSQLWithArgs()
+	test.Equal(
+		`INSERT INTO "tests" ("name","lastname","active","vectors","created_at","updated_at") VALUES ($1,$2,$3,to_tsvector(''),CURRENT_TIMESTAMP,CURRENT_TIMESTAMP),($4,$5,$6,to_tsvector(''),CURRENT_TIMESTAMP,CURRENT_TIMESTAMP) RETURNING "id";`,
+		query,
+	)
+	test.Equal([]any{"Dominik", "Linduska", false, "Conan", "O'Brien", true}, args)
+}
+
+func TestInsertSliceOfMaps(t *testing.T) {
+	test := assert.New(t)
+	q := testEntity(testCreatePostgresInstance()).
+		Insert().
+		CustomTimestamp().
+		SetValues(
+			[]map[string]any{
+				{"name": "Dominik"},
+				{"name": "Conan", "lastname": "O'Brien"},
+			},
+		)
+	query, args := q.GetSQLWithArgs()
+	test.Equal(
+		`INSERT INTO "tests" ("name","lastname","vectors") VALUES ($1,DEFAULT,to_tsvector('')),($2,$3,to_tsvector(''));`,
+		query,
+	)
+	test.Equal([]any{"Dominik", "Conan", "O'Brien"}, args)
+}
+
+func TestInsertSliceStatements(t *testing.T) {
+	test := assert.New(t)
+	rows := make([]testModel, maxQueryArgs/3+1)
+	q := testEntity(testCreatePostgresInstance()).Insert().SetValues(rows).(*insertQueryBuilder)
+	statements := q.createStatements()
+	test.Len(statements, 2)
+	test.Len(statements[0].args, maxQueryArgs)
+	test.Equal(maxQueryArgs/3, statements[0].count)
+	test.Equal(0, statements[0].offset)
+	test.Len(statements[1].args, 3)
+	test.Equal(1, statements[1].count)
+	test.Equal(maxQueryArgs/3, statements[1].offset)
+	test.Contains(statements[1].query, `VALUES ($1,$2,$3,`)
+}
+
+func TestInsertCopyValues(t *testing.T) {
+	test := assert.New(t)
+	q := testEntity(testCreatePostgresInstance()).
+		Insert().
+		CustomTimestamp().
+		SetValues([]map[string]any{{"name": "Dominik", "lastname": nil}}).(*insertQueryBuilder)
+	columns := q.getCopyColumns()
+	test.Len(columns, 2)
+	test.Equal([]any{"Dominik", ""}, q.createCopyValues(q.rows[0], columns))
+}
+
+func TestInsertVersion(t *testing.T) {
+	test := assert.New(t)
+	q := testEntity(testCreatePostgresInstance()).
+		SetVersion().
+		Insert().
+		SetValues(testModel{Name: "Dominik", Lastname: "Linduska"})
+	query, args := q.GetSQLWithArgs()
+	test.Equal(
+		`INSERT INTO "tests" ("name","lastname","active","vectors","created_at","updated_at","version") VALUES ($1,$2,$3,to_tsvector(''),CURRENT_TIMESTAMP,CURRENT_TIMESTAMP,$4);`,
+		query,
+	)
+	test.Equal([]any{"Dominik", "Linduska", false, 1}, args)
 }
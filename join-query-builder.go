@@ -15,11 +15,12 @@ type JoinQuery interface {
 
 type joinQueryBuilder struct {
 	*queryBuilder
-	joinType   string
-	entity     *entity
-	column     string
-	joinEntity *entity
-	joinColumn string
+	joinType    string
+	entity      *entity
+	column      string
+	joinEntity  *entity
+	joinColumn  string
+	withDeleted bool
 }
 
 const (
@@ -92,5 +93,15 @@ func (q *joinQueryBuilder) createQueryString() []string {
 	}
 	second = append(second, q.escape(q.joinColumn))
 	result = append(result, strings.Join(second, ""))
+	if deleted := createDeletedCondition(q.joinEntity, q.getDeletedScope()); deleted != nil {
+		result = append(result, "AND", deleted.createQueryString())
+	}
 	return result
 }
+
+func (q *joinQueryBuilder) getDeletedScope() string {
+	if q.withDeleted {
+		return deletedInclude
+	}
+	return deletedExclude
+}
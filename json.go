@@ -0,0 +1,33 @@
+package land
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+)
+
+type jsonValue struct {
+	value any
+}
+
+func (v jsonValue) Value() (driver.Value, error) {
+	if raw, ok := v.value.(json.RawMessage); ok {
+		return string(raw), nil
+	}
+	data, err := json.Marshal(v.value)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+func isJsonType(dataType string) bool {
+	return dataType == Jsonb || dataType == Json
+}
+
+func decodeJson(raw json.RawMessage) (any, error) {
+	var result any
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,121 @@
+package land
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testSettings struct {
+	Theme  string   `json:"theme"`
+	Labels []string `json:"labels"`
+}
+
+type testJsonModel struct {
+	Name     string
+	Settings testSettings
+	Payload  map[string]any
+}
+
+func testJsonEntity(l Land) Entity {
+	return l.CreateEntity(testEntityName).
+		SetAlias(testEntityAlias).
+		SetColumn(testName, Varchar, ColOpts{Limit: 255, NotNull: true}).
+		SetColumn("settings", Jsonb, ColOpts{NotNull: true}).
+		SetColumn("payload", Jsonb, ColOpts{})
+}
+
+func testJsonArg(t *testing.T, arg any) any {
+	value, err := arg.(driver.Valuer).Value()
+	assert.NoError(t, err)
+	return value
+}
+
+func TestInsertJson(t *testing.T) {
+	test := assert.New(t)
+	q := testJsonEntity(testCreatePostgresInstance()).
+		Insert().
+		SetValues(testJsonModel{Name: "Dominik", Settings: testSettings{Theme: "dark", Labels: []string{"a"}}})
+	query, args := q.GetSQLWithArgs()
+	test.Equal(`INSERT INTO "tests" ("name","settings","payload") VALUES ($1,$2,NULL);`, query)
+	test.Len(args, 2)
+	test.Equal(`{"theme":"dark","labels":["a"]}`, testJsonArg(t, args[1]))
+}
+
+func TestUpdateJsonSlice(t *testing.T) {
+	test := assert.New(t)
+	q := testJsonEntity(testCreatePostgresInstance()).
+		Update().
+		SetValues(map[string]any{"payload": []int{1, 2}})
+	query, args := q.GetSQLWithArgs()
+	test.Equal(`UPDATE "tests" AS "t" SET "payload" = $1;`, query)
+	test.Equal(`[1,2]`, testJsonArg(t, args[0]))
+}
+
+func TestSelectWhereJson(t *testing.T) {
+	test := assert.New(t)
+	q := testJsonEntity(testCreatePostgresInstance()).Select()
+	q.Where().Column("settings").JsonContains(map[string]any{"theme": "dark"})
+	q.Where().Column("payload").HasKey("source")
+	q.Where().Column("settings").Path("editor", "font").Equal("mono")
+	q.All()
+	query, args := q.GetSQLWithArgs()
+	test.Equal(
+		`SELECT * FROM "tests" AS "t" WHERE "t"."settings" @> $1 AND "t"."payload" ? $2 AND "t"."settings"->$3->>$4 = $5;`,
+		query,
+	)
+	test.Len(args, 5)
+	test.Equal(`{"theme":"dark"}`, testJsonArg(t, args[0]))
+	test.Equal([]any{"source", "editor", "font", "mono"}, args[1:])
+}
+
+func TestSelectWhereJsonPath(t *testing.T) {
+	test := assert.New(t)
+	q := testJsonEntity(testCreatePostgresInstance()).Select()
+	q.Where().Column("settings").Path("editor").HasKey("font")
+	q.Where().Column("settings").Path("editor").JsonContains(map[string]any{"font": "mono"})
+	q.All()
+	query, args := q.GetSQLWithArgs()
+	test.Equal(
+		`SELECT * FROM "tests" AS "t" WHERE "t"."settings"->$1 ? $2 AND "t"."settings"->$3 @> $4;`,
+		query,
+	)
+	test.Len(args, 4)
+	test.Equal([]any{"editor", "font", "editor"}, args[:3])
+	test.Equal(`{"font":"mono"}`, testJsonArg(t, args[3]))
+}
+
+func TestScanJson(t *testing.T) {
+	test := assert.New(t)
+	m := createQueryManager(nil, nil)
+	raw := any([]byte(`{"theme":"dark","labels":["a","b"]}`))
+	value, err := m.getScannedValue(Jsonb, &raw)
+	test.NoError(err)
+	test.Equal(json.RawMessage(`{"theme":"dark","labels":["a","b"]}`), value)
+	var result testJsonModel
+	m.setDest(&result)
+	m.setValueToStruct(m.destRef.v, "settings", value)
+	m.setValueToStruct(m.destRef.v, "payload", json.RawMessage(`{"count":1}`))
+	test.Equal(testSettings{Theme: "dark", Labels: []string{"a", "b"}}, result.Settings)
+	test.Equal(map[string]any{"count": float64(1)}, result.Payload)
+	rows := make([]map[string]any, 0)
+	m = createQueryManager(nil, nil).setDest(&rows)
+	row := m.createRowDataModel()
+	m.setValue(row, "settings", value)
+	test.Equal(map[string]any{"theme": "dark", "labels": []any{"a", "b"}}, row.Interface().(map[string]any)["Settings"])
+}
+
+func TestScanMalformedJson(t *testing.T) {
+	test := assert.New(t)
+	l, fake := testFakeLand(t)
+	fake.setResult(testEntityName, []string{"settings"}, []driver.Value{[]byte(`{"theme":`)})
+	fake.setColumnTypes(testEntityName, "JSONB")
+	e := testEntity(l).SetColumn("settings", Jsonb, ColOpts{})
+	rows := make([]map[string]any, 0)
+	test.ErrorContains(e.Select().ScanE(context.Background(), &rows), "assign column settings")
+	result := make([]struct{ Settings map[string]any }, 0)
+	test.ErrorContains(e.Select().ScanE(context.Background(), &result), "assign column settings")
+}
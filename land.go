@@ -1,6 +1,8 @@
 package land
 
 import (
+	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 
@@ -11,10 +13,15 @@ type Land interface {
 	CreateEntity(name string) Entity
 	Migrator(migrationsManager MigrationsManager) Migrator
 	Ping() error
+	PingContext(ctx context.Context) error
+	Stats() sql.DBStats
 	Begin() error
 	Commit() error
 	Rollback() error
+	Transaction() Transaction
+	InTransaction(ctx context.Context, fn func(tx Land) error) error
 	Query(query string, args ...any) ([]map[string]any, error)
+	QueryContext(ctx context.Context, query string, args ...any) ([]map[string]any, error)
 	FixSequence(table string) error
 	Reset(table string) error
 
@@ -22,10 +29,11 @@ type Land interface {
 }
 
 type land struct {
-	db        *db
-	entities  []*entity
-	config    Config
-	migration bool
+	db          *db
+	entities    []*entity
+	config      Config
+	transaction *transactionManager
+	err         error
 }
 
 func New(config Config, connector Connector) Land {
@@ -33,11 +41,29 @@ func New(config Config, connector Connector) Land {
 		config: config,
 	}
 	if connector != nil {
-		l.db = createConnection(config, connector.getPtr())
+		l.db, l.err = createConnection(config, connector.getPtr())
+	}
+	if l.err != nil {
+		l.logger().Println(createErrorMessage(l.err, "land failed connect to the database", ""))
 	}
 	return l
 }
 
+func Open(config Config, connector Connector) (Land, error) {
+	if connector == nil {
+		return nil, ErrNoConnector
+	}
+	d, err := createConnection(config, connector.getPtr())
+	if err != nil {
+		return nil, err
+	}
+	if err := d.ping(context.Background()); err != nil {
+		_ = d.connection.Close()
+		return nil, err
+	}
+	return &land{db: d, config: config}, nil
+}
+
 func (l *land) CreateEntity(name string) Entity {
 	e := createEntity(l, name)
 	l.entities = append(l.entities, e)
@@ -45,11 +71,16 @@ func (l *land) CreateEntity(name string) Entity {
 }
 
 func (l *land) Query(query string, args ...any) ([]map[string]any, error) {
+	return l.QueryContext(context.Background(), query, args...)
+}
+
+func (l *land) QueryContext(ctx context.Context, query string, args ...any) ([]map[string]any, error) {
 	result := make([]map[string]any, 0)
-	rows, err := l.db.connection.Query(query, args...)
+	rows, err := l.executor().QueryContext(ctx, query, args...)
 	if err != nil {
 		return result, err
 	}
+	defer rows.Close()
 	cols, err := rows.Columns()
 	if err != nil {
 		return result, err
@@ -70,32 +101,52 @@ func (l *land) Query(query string, args ...any) ([]map[string]any, error) {
 		}
 		result = append(result, rowResult)
 	}
+	if err = rows.Err(); err != nil {
+		return result, err
+	}
 	return result, rows.Close()
 }
 
 func (l *land) Begin() error {
-	_, err := l.db.connection.Exec("BEGIN;")
-	return err
+	if l.transaction == nil {
+		return ErrTxNotStarted
+	}
+	if err := l.checkConnection(); err != nil {
+		return err
+	}
+	return l.transaction.begin(l.db.connection)
 }
 
 func (l *land) Commit() error {
-	_, err := l.db.connection.Exec("COMMIT;")
-	return err
+	if l.transaction == nil {
+		return ErrTxNotStarted
+	}
+	return l.transaction.commit()
 }
 
 func (l *land) Rollback() error {
-	_, err := l.db.connection.Exec("ROLLBACK;")
-	return err
+	if l.transaction == nil {
+		return ErrTxNotStarted
+	}
+	return l.transaction.rollback()
+}
+
+func (l *land) InTransaction(ctx context.Context, fn func(tx Land) error) error {
+	if l.transaction != nil && l.transaction.isActive() {
+		return fn(l)
+	}
+	return runInTransaction(l.createTransaction(ctx), fn)
 }
 
 func (l *land) FixSequence(table string) error {
 	table = strcase.ToSnake(table)
-	_, err := l.db.connection.Exec(fmt.Sprintf("SELECT setval('%[1]s_id_seq', (SELECT MAX(id) FROM %[1]s));", table))
+	_, err := l.executor().ExecContext(context.Background(), fmt.Sprintf("SELECT setval('%[1]s_id_seq', (SELECT MAX(id) FROM %[1]s));", table))
 	return err
 }
 
 func (l *land) Reset(table string) error {
-	_, err := l.db.connection.Exec(
+	_, err := l.executor().ExecContext(
+		context.Background(),
 		fmt.Sprintf(
 			`TRUNCATE TABLE %[1]s RESTART IDENTITY CASCADE; ALTER SEQUENCE %[1]s_id_seq RESTART WITH 1
 ;`, table,
@@ -105,19 +156,71 @@ func (l *land) Reset(table string) error {
 }
 
 func (l *land) Migrator(migrationsManager MigrationsManager) Migrator {
-	l.migration = true
 	return createMigrator(l, migrationsManager.getPtr())
 }
 
 func (l *land) Ping() error {
+	return l.PingContext(context.Background())
+}
+
+func (l *land) PingContext(ctx context.Context) error {
+	if err := l.checkConnection(); err != nil {
+		return err
+	}
+	return l.db.connection.PingContext(ctx)
+}
+
+func (l *land) checkConnection() error {
+	if l.err != nil {
+		return l.err
+	}
 	if l.db == nil {
 		return errors.New("land failed connect to the database")
 	}
-	return l.db.connection.Ping()
+	return nil
+}
+
+func (l *land) Stats() sql.DBStats {
+	if l.db == nil {
+		return sql.DBStats{}
+	}
+	return l.db.connection.Stats()
 }
 
 func (l *land) Transaction() Transaction {
-	return createTransactionManager(l)
+	if l.transaction != nil {
+		return l
+	}
+	return l.createTransaction(context.Background())
+}
+
+func (l *land) createTransaction(ctx context.Context) *land {
+	return &land{
+		db:          l.db,
+		config:      l.config,
+		transaction: createTransactionManager(ctx),
+		err:         l.err,
+	}
+}
+
+func (l *land) executor() executor {
+	if err := l.checkConnection(); err != nil {
+		return failedExecutor{err: err}
+	}
+	if l.transaction == nil {
+		return l.db.connection
+	}
+	if err := l.transaction.check(); err != nil {
+		return failedExecutor{err: err}
+	}
+	return l.transaction.tx
+}
+
+func (l *land) logger() Logger {
+	if l.config.Logger == nil {
+		return createDefaultLogger()
+	}
+	return l.config.Logger
 }
 
 func (l *land) getPtr() *land {
@@ -0,0 +1,40 @@
+package land
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpenValidation(t *testing.T) {
+	test := assert.New(t)
+	_, err := Open(Config{}, nil)
+	test.ErrorIs(err, ErrNoConnector)
+	_, err = Open(Config{}, Connect().Host("localhost"))
+	test.ErrorIs(err, ErrNoDatabase)
+	_, err = Open(Config{}, Connect().URL("mysql://localhost/land"))
+	test.Error(err)
+}
+
+func TestOpenPingFailure(t *testing.T) {
+	test := assert.New(t)
+	l, err := Open(
+		Config{},
+		Connect().Postgres().Host("127.0.0.1").Port(1).ConnectTimeout(time.Second),
+	)
+	test.Error(err)
+	test.Nil(l)
+}
+
+func TestNewConnectionFailure(t *testing.T) {
+	test := assert.New(t)
+	l := New(Config{Logger: &testHookLogger{}}, Connect().Host("localhost"))
+	test.ErrorIs(l.Ping(), ErrNoDatabase)
+	_, err := testEntity(l).Delete().ExecE(context.Background())
+	test.ErrorIs(err, ErrNoDatabase)
+	_, err = testEntity(l).Select().Rows(context.Background())
+	test.ErrorIs(err, ErrNoDatabase)
+	test.ErrorIs(l.InTransaction(context.Background(), func(tx Land) error { return nil }), ErrNoDatabase)
+}
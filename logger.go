@@ -0,0 +1,13 @@
+package land
+
+import (
+	"log"
+)
+
+type Logger interface {
+	Println(v ...any)
+}
+
+func createDefaultLogger() Logger {
+	return log.Default()
+}
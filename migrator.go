@@ -1,6 +1,8 @@
 package land
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strconv"
@@ -10,16 +12,15 @@ import (
 )
 
 type Migrator interface {
-	Init()
-	New()
-	Up()
-	Down()
+	Init() error
+	New() error
+	Up() error
+	Down() error
 }
 
 type migrator struct {
 	land              *land
 	migrationsManager *migrationsManager
-	errorHandler      *errorHandler
 }
 
 type landMigration struct {
@@ -68,30 +69,32 @@ func createMigrator(land *land, migrationsManager *migrationsManager) Migrator {
 	return &migrator{
 		land:              land,
 		migrationsManager: migrationsManager,
-		errorHandler:      createErrorHandler(land),
 	}
 }
 
-func (m *migrator) Init() {
+func (m *migrator) Init() error {
 	fmt.Println("### Initializing...")
-	m.createMigrationsEntity().CreateTable().IfNotExists().Exec()
+	if _, err := m.createMigrationsEntity(m.land).CreateTable().IfNotExists().ExecE(context.Background()); err != nil {
+		return err
+	}
 	fmt.Println("### INIT SUCCESS!")
+	return nil
 }
 
-func (m *migrator) New() {
-	// defer m.errorHandler.recover()
-	m.createMigration()
+func (m *migrator) New() error {
+	return m.createMigration()
 }
 
-func (m *migrator) Up() {
-	// defer m.errorHandler.recover()
+func (m *migrator) Up() error {
 	dbMigrations := make([]landMigration, 0)
-	lm := m.createMigrationsEntity()
 	{
-		q := lm.Select()
+		q := m.createMigrationsEntity(m.land).Select()
 		q.Columns(Id, Name)
 		q.Order().Asc(CreatedAt)
-		q.GetResult(&dbMigrations)
+		q.All()
+		if err := q.ScanE(context.Background(), &dbMigrations); err != nil {
+			return err
+		}
 	}
 	for _, fileMigration := range m.migrationsManager.migrations {
 		var exist bool
@@ -104,51 +107,71 @@ func (m *migrator) Up() {
 			continue
 		}
 		fmt.Println("### Migrating: " + fileMigration.id)
-		tx := m.land.Transaction()
-		tx.Begin()
-		fileMigration.up(m.land)
-		tx.Commit()
-		lm.Insert().SetValues(landMigration{Name: fileMigration.id}).Exec()
+		err := m.land.InTransaction(
+			context.Background(), func(tx Land) error {
+				if err := m.run(tx, fileMigration.up); err != nil {
+					return err
+				}
+				_, err := m.createMigrationsEntity(tx).Insert().SetValues(landMigration{Name: fileMigration.id}).ExecE(context.Background())
+				return err
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("land: migration %s failed: %w", fileMigration.id, err)
+		}
 		fmt.Println("### MIGRATION SUCCESS: " + fileMigration.id)
 	}
+	return nil
 }
 
-func (m *migrator) Down() {
-	// defer m.errorHandler.recover()
+func (m *migrator) Down() error {
 	var lastMigration landMigration
 	{
-		lm := m.createMigrationsEntity()
-		q := lm.Select()
+		q := m.createMigrationsEntity(m.land).Select()
 		q.Columns(Id, Name)
 		q.Order().Desc(CreatedAt)
-		q.Single().GetResult(&lastMigration)
-		if lm.IsError() {
-			err := lm.Error()
-			m.errorHandler.createErrorMessage(err.Error, err.Query, err.Message)
-			return
+		if err := q.Single().ScanE(context.Background(), &lastMigration); err != nil {
+			return err
 		}
 	}
 	migration := m.getMigrationWithId(lastMigration.Name)
 	if migration == nil {
-		return
+		return nil
 	}
 	fmt.Println("### Rollbacking: " + migration.id)
-	tx := m.land.Transaction()
-	tx.Begin()
-	migration.down(m.land)
-	tx.Commit()
-	{
-		lm := m.createMigrationsEntity()
-		q := lm.Delete()
-		q.Where().Column(Name).Equal(migration.id)
-		q.Exec()
-		if lm.IsError() {
-			err := lm.Error()
-			m.errorHandler.createErrorMessage(err.Error, err.Query, err.Message)
-			return
-		}
+	err := m.land.InTransaction(
+		context.Background(), func(tx Land) error {
+			if err := m.run(tx, migration.down); err != nil {
+				return err
+			}
+			q := m.createMigrationsEntity(tx).Delete()
+			q.Where().Column(Name).Equal(migration.id)
+			_, err := q.ExecE(context.Background())
+			return err
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("land: rollback %s failed: %w", migration.id, err)
 	}
 	fmt.Println("### ROLLBACK SUCCESS: " + migration.id)
+	return nil
+}
+
+func (m *migrator) run(tx Land, fn func(l Land)) (err error) {
+	if fn == nil {
+		return nil
+	}
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			landErr, ok := recovered.(Error)
+			if !ok {
+				panic(recovered)
+			}
+			err = landErr.Error
+		}
+	}()
+	fn(tx)
+	return nil
 }
 
 func (m *migrator) getRoot() string {
@@ -179,8 +202,8 @@ func (m *migrator) getMigrationsMainFileDir() string {
 	return dir + "/" + migrationsMainFile
 }
 
-func (m *migrator) createMigrationsEntity() Entity {
-	return m.land.CreateEntity(migrationsEntityName).
+func (m *migrator) createMigrationsEntity(l Land) Entity {
+	return l.CreateEntity(migrationsEntityName).
 		SetAlias(migrationsEntityAlias).
 		SetColumn(Name, Text, ColOpts{NotNull: true}).
 		SetCreatedAt().
@@ -196,51 +219,55 @@ func (m *migrator) getMigrationWithId(id string) *migration {
 	return nil
 }
 
-func (m *migrator) createMigration() Migrator {
+func (m *migrator) createMigration() error {
 	dir := m.getDir()
 	if len(dir) == 0 {
-		return m
+		return errors.New("land: migrations folder not found")
 	}
 	id := strconv.FormatInt(time.Now().UnixNano(), 10) + "_" + uniuri.New()
 	filedir := dir + "/" + id + ".go"
-	if _, err := os.Stat(filedir); os.IsNotExist(err) {
-		file, err := os.Create(filedir)
-		if err != nil {
-			m.errorHandler.createErrorMessage(err, "create new migration file failed", "")
-		}
-		_, err = file.WriteString(fmt.Sprintf(newMigrationFileContent, id))
-		if err != nil {
-			m.errorHandler.createErrorMessage(err, "write init content to new migration failed", "")
-		}
+	if _, err := os.Stat(filedir); !os.IsNotExist(err) {
+		return nil
+	}
+	file, err := os.Create(filedir)
+	if err != nil {
+		return fmt.Errorf("land: create new migration file failed: %w", err)
 	}
-	return m
+	defer file.Close()
+	if _, err = file.WriteString(fmt.Sprintf(newMigrationFileContent, id)); err != nil {
+		return fmt.Errorf("land: write init content to new migration failed: %w", err)
+	}
+	return nil
 }
 
-func (m *migrator) verifyMigrationsDir() {
+func (m *migrator) verifyMigrationsDir() error {
 	dir := m.getDir()
 	if len(dir) == 0 {
-		return
+		return nil
 	}
 	if _, err := os.Stat(dir); os.IsNotExist(err) {
 		if err := os.Mkdir(dir, os.ModePerm); err != nil {
-			m.errorHandler.createErrorMessage(err, "create migrations folder failed", "")
+			return fmt.Errorf("land: create migrations folder failed: %w", err)
 		}
 	}
+	return nil
 }
 
-func (m *migrator) verifyMainMigrationsFile() {
+func (m *migrator) verifyMainMigrationsFile() error {
 	dir := m.getMigrationsMainFileDir()
 	if len(dir) == 0 {
-		return
+		return nil
 	}
-	if _, err := os.Stat(dir); os.IsNotExist(err) {
-		file, err := os.Create(dir)
-		if err != nil {
-			m.errorHandler.createErrorMessage(err, "write init content to new migration failed", "")
-		}
-		_, err = file.WriteString(mainMigrationsFileContent)
-		if err != nil {
-			m.errorHandler.createErrorMessage(err, "write init content to new migration failed", "")
-		}
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		return nil
 	}
+	file, err := os.Create(dir)
+	if err != nil {
+		return fmt.Errorf("land: create main migrations file failed: %w", err)
+	}
+	defer file.Close()
+	if _, err = file.WriteString(mainMigrationsFileContent); err != nil {
+		return fmt.Errorf("land: write init content to main migrations file failed: %w", err)
+	}
+	return nil
 }
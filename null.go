@@ -0,0 +1,35 @@
+package land
+
+import (
+	"database/sql/driver"
+	"reflect"
+)
+
+type Null[T any] struct {
+	V     T
+	Valid bool
+}
+
+func NullOf[T any](value T) Null[T] {
+	return Null[T]{V: value, Valid: true}
+}
+
+func (n *Null[T]) Scan(value any) error {
+	if value == nil {
+		var zero T
+		n.V, n.Valid = zero, false
+		return nil
+	}
+	if err := assignValue(reflect.ValueOf(&n.V).Elem(), value); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+func (n Null[T]) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return driver.DefaultParameterConverter.ConvertValue(n.V)
+}
@@ -0,0 +1,117 @@
+package land
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testStatus string
+
+type testNullableModel struct {
+	Nickname *string
+	Age      Null[int]
+	Score    sql.NullFloat64
+	Visits   int
+	Active   bool
+}
+
+func testNullableEntity(l Land) Entity {
+	return l.CreateEntity(testEntityName).
+		SetAlias(testEntityAlias).
+		SetColumn("nickname", Varchar, ColOpts{Limit: 255}).
+		SetColumn("age", Int, ColOpts{}).
+		SetColumn("score", Float, ColOpts{}).
+		SetColumn("visits", Int, ColOpts{}).
+		SetColumn(testActive, Boolean, ColOpts{})
+}
+
+func TestNullScan(t *testing.T) {
+	test := assert.New(t)
+	var n Null[int]
+	test.NoError(n.Scan(int64(5)))
+	test.Equal(NullOf(5), n)
+	test.NoError(n.Scan(nil))
+	test.Equal(Null[int]{}, n)
+	var s Null[string]
+	test.NoError(s.Scan([]byte("land")))
+	test.Equal(NullOf("land"), s)
+	test.Error(s.Scan(true))
+}
+
+func TestAssignValueMismatch(t *testing.T) {
+	test := assert.New(t)
+	var dest struct{ Sec int }
+	test.Error(assignValue(reflect.ValueOf(&dest).Elem(), time.Now()))
+	var status testStatus
+	test.NoError(assignValue(reflect.ValueOf(&status).Elem(), "active"))
+	test.Equal(testStatus("active"), status)
+}
+
+func TestNullValue(t *testing.T) {
+	test := assert.New(t)
+	value, err := Null[int]{}.Value()
+	test.NoError(err)
+	test.Nil(value)
+	value, err = NullOf(5).Value()
+	test.NoError(err)
+	test.Equal(int64(5), value)
+}
+
+func TestInsertNullable(t *testing.T) {
+	test := assert.New(t)
+	nickname := "dom"
+	q := testNullableEntity(testCreatePostgresInstance()).
+		Insert().
+		SetValues(testNullableModel{Nickname: &nickname, Score: sql.NullFloat64{Float64: 1.5, Valid: true}})
+	query, args := q.GetSQLWithArgs()
+	test.Equal(
+		`INSERT INTO "tests" ("nickname","age","score","visits","active") VALUES ($1,NULL,$2,$3,$4);`,
+		query,
+	)
+	test.Equal([]any{"dom", 1.5, int64(0), false}, args)
+}
+
+func TestUpdateNullable(t *testing.T) {
+	test := assert.New(t)
+	q := testNullableEntity(testCreatePostgresInstance()).
+		Update().
+		SetValues(testNullableModel{Age: NullOf(30)})
+	query, args := q.GetSQLWithArgs()
+	test.Equal(
+		`UPDATE "tests" AS "t" SET "nickname" = NULL,"age" = $1,"score" = NULL,"visits" = $2,"active" = $3;`,
+		query,
+	)
+	test.Equal([]any{int64(30), int64(0), false}, args)
+}
+
+func TestScanNullable(t *testing.T) {
+	test := assert.New(t)
+	nickname := "dom"
+	result := testNullableModel{Nickname: &nickname, Age: NullOf(1), Visits: 3}
+	m := createQueryManager(nil, nil).setDest(&result)
+	m.setValueToStruct(m.destRef.v, "nickname", nil)
+	m.setValueToStruct(m.destRef.v, "age", nil)
+	m.setValueToStruct(m.destRef.v, "score", 2.5)
+	m.setValueToStruct(m.destRef.v, "visits", nil)
+	test.Nil(result.Nickname)
+	test.False(result.Age.Valid)
+	test.Equal(sql.NullFloat64{Float64: 2.5, Valid: true}, result.Score)
+	test.Equal(0, result.Visits)
+	m.setValueToStruct(m.destRef.v, "nickname", "linduska")
+	m.setValueToStruct(m.destRef.v, "age", 30)
+	test.Equal("linduska", *result.Nickname)
+	test.Equal(NullOf(30), result.Age)
+}
+
+func TestScanNullableMap(t *testing.T) {
+	test := assert.New(t)
+	result := make(map[string]any)
+	m := createQueryManager(nil, nil).setDest(&result)
+	m.setValue(m.destRef.v, "nickname", nil)
+	test.Contains(result, "Nickname")
+	test.Nil(result["Nickname"])
+}
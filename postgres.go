@@ -17,7 +17,7 @@ func createTSQuery(value string) string {
 			result = append(result, item+":*")
 		}
 	}
-	return fmt.Sprintf("to_tsquery('%s')", strings.Join(result, " & "))
+	return strings.Join(result, " & ")
 }
 
 func createTSVectors(values ...any) string {
@@ -30,5 +30,5 @@ func createTSVectors(values ...any) string {
 			result = append(result, s)
 		}
 	}
-	return fmt.Sprintf("to_tsvector('%s')", strings.Join(result, " "))
+	return strings.Join(result, " ")
 }
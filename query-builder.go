@@ -1,6 +1,7 @@
 package land
 
 import (
+	"database/sql/driver"
 	"fmt"
 	"reflect"
 	"slices"
@@ -10,10 +11,28 @@ import (
 
 type queryBuilder struct {
 	queryType string
+	args      *queryArgs
+}
+
+type queryArgs struct {
+	values []any
 }
 
 func createQueryBuilder() *queryBuilder {
-	return &queryBuilder{}
+	return &queryBuilder{
+		args: createQueryArgs(),
+	}
+}
+
+func createQueryArgs() *queryArgs {
+	return &queryArgs{
+		values: make([]any, 0),
+	}
+}
+
+func (a *queryArgs) add(value any) string {
+	a.values = append(a.values, value)
+	return fmt.Sprintf("$%d", len(a.values))
 }
 
 func (q *queryBuilder) setQueryType(queryType string) *queryBuilder {
@@ -21,6 +40,50 @@ func (q *queryBuilder) setQueryType(queryType string) *queryBuilder {
 	return q
 }
 
+func (q *queryBuilder) resetArgs() {
+	q.args = createQueryArgs()
+}
+
+func (q *queryBuilder) shareArgs(child *queryBuilder) {
+	child.args = q.args
+}
+
+func (q *queryBuilder) getArgs() []any {
+	return q.args.values
+}
+
+func (q *queryBuilder) isLiteral() bool {
+	return q.queryType == CreateTable || q.queryType == AlterTable
+}
+
+func (q *queryBuilder) bind(value any) string {
+	if q.isLiteral() {
+		return q.createLiteral(value)
+	}
+	return q.args.add(value)
+}
+
+func (q *queryBuilder) createLiteral(value any) string {
+	switch v := value.(type) {
+	case driver.Valuer:
+		driverValue, err := v.Value()
+		if err != nil || driverValue == nil {
+			return "NULL"
+		}
+		return q.createLiteral(driverValue)
+	case string:
+		return q.quote(v)
+	case time.Time:
+		return q.quote(v.Format(time.DateTime))
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+func (q *queryBuilder) quote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
 func (q *queryBuilder) escape(value string) string {
 	return fmt.Sprintf(`"%s"`, value)
 }
@@ -52,28 +115,72 @@ func (q *queryBuilder) getMapValue(mapValue reflect.Value, key string) reflect.V
 	return mapValue.MapIndex(reflect.ValueOf(key))
 }
 
+func (q *queryBuilder) getDataField(data ref, c *column) (reflect.Value, *structField) {
+	switch data.v.Kind() {
+	case reflect.Map:
+		return q.getMapValue(data.v, c.name), nil
+	case reflect.Struct:
+		field := getStructFields(data.v.Type()).get(c.name)
+		if field == nil {
+			return reflect.Value{}, nil
+		}
+		return field.read(data.v), field
+	default:
+		return reflect.Value{}, nil
+	}
+}
+
+func (q *queryBuilder) shouldSkipField(field reflect.Value, structField *structField) bool {
+	if structField == nil {
+		return false
+	}
+	return structField.readonly || (structField.omitempty && field.IsZero())
+}
+
 func (q *queryBuilder) createValue(column *column, value reflect.Value) string {
-	if column == nil {
+	if column == nil || !value.IsValid() {
 		return ""
 	}
+	if value.Kind() == reflect.Interface {
+		value = reflect.ValueOf(value.Interface())
+	}
+	if isNullValue(value) {
+		return "NULL"
+	}
+	if value.Type().Implements(valuerType) {
+		return q.createDriverValue(value.Interface().(driver.Valuer))
+	}
+	if codec := getTypeCodec(column.dataType); codec != nil {
+		return q.bind(codecValue{codec: codec, value: value.Interface()})
+	}
 	if value.Kind() == reflect.Ptr {
 		value = value.Elem()
 	}
-	if value.Kind() == reflect.Slice {
+	if value.Kind() == reflect.Slice && !isJsonType(column.dataType) && !isArrayType(column.dataType) {
 		return q.createSliceValue(value)
 	}
 	return q.createAnyValue(column, value)
 }
 
+func (q *queryBuilder) createDriverValue(valuer driver.Valuer) string {
+	value, err := valuer.Value()
+	if err != nil || value == nil {
+		return "NULL"
+	}
+	return q.bind(value)
+}
+
 func (q *queryBuilder) createSliceValue(value reflect.Value) string {
 	sliceItems := make([]string, value.Len())
 	sliceType := value.Type().Elem().Kind()
 	for i := 0; i < value.Len(); i++ {
 		switch sliceType {
 		case reflect.String:
-			sliceItems[i] = fmt.Sprintf("'%s'", value.Index(i).String())
+			sliceItems[i] = q.bind(value.Index(i).String())
 		case reflect.Int:
-			sliceItems[i] = fmt.Sprintf("%d", value.Index(i).Int())
+			sliceItems[i] = q.bind(value.Index(i).Int())
+		default:
+			sliceItems[i] = q.bind(value.Index(i).Interface())
 		}
 	}
 	return strings.Join(sliceItems, ",")
@@ -91,6 +198,9 @@ func (q *queryBuilder) createAnyValue(column *column, value reflect.Value) strin
 
 func (q *queryBuilder) validateValueKind(dataType string, value reflect.Value) bool {
 	kind := value.Kind()
+	if isArrayType(dataType) {
+		return kind == reflect.String || kind == reflect.Slice || kind == reflect.Array
+	}
 	switch dataType {
 	case TsVector:
 		return kind == reflect.String
@@ -101,76 +211,84 @@ func (q *queryBuilder) validateValueKind(dataType string, value reflect.Value) b
 	case Char:
 		return kind == reflect.String
 	case Serial:
-		return kind == reflect.Int
+		return isIntKind(kind)
 	case Int:
-		return kind == reflect.Int
+		return isIntKind(kind)
 	case BigInt:
-		return kind == reflect.Int
+		return isIntKind(kind)
 	case Float:
-		return kind == reflect.Float32 || kind == reflect.Float64 || kind == reflect.Int
+		return isFloatKind(kind) || isIntKind(kind)
 	case Bool:
 		return kind == reflect.Bool
 	case Boolean:
 		return kind == reflect.Bool
+	case Json, Jsonb:
+		return kind == reflect.String || kind == reflect.Struct || kind == reflect.Map || kind == reflect.Slice || kind == reflect.Array
 	case Timestamp:
 		return kind == reflect.String || kind == reflect.Struct
 	case TimestampWithZone:
 		return kind == reflect.String || kind == reflect.Struct
 	default:
-		return false
+		return kind != reflect.Invalid
 	}
 }
 
 func (q *queryBuilder) getValueByColumnDataType(dataType string, value reflect.Value) string {
 	kind := value.Kind()
+	if isArrayType(dataType) && kind == reflect.String {
+		return q.bind(value.String())
+	}
+	if isArrayType(dataType) {
+		return q.bind(createArrayValue(value.Interface()))
+	}
 	switch dataType {
 	case TsVector:
 		if q.queryType == Where {
-			return fmt.Sprintf(`%s`, createTSQuery(value.String()))
+			return q.createTSQueryValue(createTSQuery(value.String()))
 		}
-		return fmt.Sprintf(`%s`, createTSVectors(value.String()))
+		return q.createTSVectorsValue(createTSVectors(value.String()))
 	case Varchar:
-		return fmt.Sprintf(`'%s'`, value.String())
+		return q.bind(value.String())
 	case Text:
-		return fmt.Sprintf(`'%s'`, value.String())
+		return q.bind(value.String())
 	case Char:
-		return fmt.Sprintf(`'%s'`, value.String())
+		return q.bind(value.String())
 	case Serial:
-		return fmt.Sprintf(`%d`, value.Int())
+		return q.bind(getIntValue(value))
 	case Int:
-		return fmt.Sprintf(`%d`, value.Int())
+		return q.bind(getIntValue(value))
 	case BigInt:
-		return fmt.Sprintf(`%d`, value.Int())
+		return q.bind(getIntValue(value))
 	case Float:
-		if value.Kind() == reflect.Int {
-			return fmt.Sprintf(`%d`, value.Int())
+		if isIntKind(kind) {
+			return q.bind(getIntValue(value))
 		}
-		return fmt.Sprintf(`%f`, value.Float())
+		return q.bind(value.Float())
 	case Bool:
-		return fmt.Sprintf(`%t`, value.Bool())
+		return q.bind(value.Bool())
 	case Boolean:
-		return fmt.Sprintf(`%t`, value.Bool())
-	case Timestamp:
+		return q.bind(value.Bool())
+	case Json, Jsonb:
 		if kind == reflect.String {
-			return fmt.Sprintf("%s", value.String())
+			return q.bind(value.String())
+		}
+		return q.bind(jsonValue{value: value.Interface()})
+	case Timestamp, TimestampWithZone:
+		if kind == reflect.String && value.String() == CurrentTimestamp {
+			return CurrentTimestamp
 		}
-		return fmt.Sprintf("'%s'", value.Interface().(time.Time).Format(time.DateTime))
-	case TimestampWithZone:
 		if kind == reflect.String {
-			return fmt.Sprintf("%s", value.String())
+			return q.bind(value.String())
 		}
-		return fmt.Sprintf("'%s'", value.Interface().(time.Time).Format(time.DateTime))
+		return q.bind(value.Interface().(time.Time))
 	default:
-		return ""
+		return q.bind(value.Interface())
 	}
 }
 
 func (q *queryBuilder) createDefaultValue(column *column, value reflect.Value) reflect.Value {
 	if column.dataType == TsVector {
-		if q.queryType == Where {
-			return reflect.ValueOf(createTSQuery(""))
-		}
-		return reflect.ValueOf(createTSVectors(""))
+		return reflect.ValueOf("")
 	}
 	if value.IsValid() {
 		return value
@@ -187,17 +305,28 @@ func (q *queryBuilder) createDefaultValue(column *column, value reflect.Value) r
 	return reflect.ValueOf("")
 }
 
+func (q *queryBuilder) createTSVectorsValue(text string) string {
+	if len(text) == 0 {
+		return "to_tsvector('')"
+	}
+	return fmt.Sprintf("to_tsvector(%s)", q.bind(text))
+}
+
+func (q *queryBuilder) createTSQueryValue(text string) string {
+	return fmt.Sprintf("to_tsquery(%s)", q.bind(text))
+}
+
 func (q *queryBuilder) createValueWithUnknownColumn(valueRef ref) string {
 	switch valueRef.kind {
 	case reflect.String:
-		return fmt.Sprintf(`'%s'`, valueRef.v.String())
+		return q.bind(valueRef.v.String())
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		return fmt.Sprintf(`%d`, int(valueRef.v.Int()))
+		return q.bind(valueRef.v.Int())
 	case reflect.Float32, reflect.Float64:
-		return fmt.Sprintf(`%f`, valueRef.v.Float())
+		return q.bind(valueRef.v.Float())
 	case reflect.Bool:
-		return fmt.Sprintf(`%t`, valueRef.v.Bool())
+		return q.bind(valueRef.v.Bool())
 	default:
-		return fmt.Sprintf("%v", valueRef.v.Interface())
+		return q.bind(valueRef.v.Interface())
 	}
 }
@@ -3,14 +3,12 @@ package land
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
 	"strings"
 	"time"
-	
-	"github.com/iancoleman/strcase"
-	
-	"util/numx"
 )
 
 type queryManager struct {
@@ -18,9 +16,13 @@ type queryManager struct {
 	context    context.Context
 	queryType  string
 	query      string
+	args       []any
 	dest       any
 	destRef    ref
 	resultType string
+	rowsCount  int
+	checkStale bool
+	afterFind  func(dest any) error
 	errors     []error
 	duration   time.Duration
 }
@@ -37,12 +39,21 @@ func (m *queryManager) setQuery(query string) *queryManager {
 	return m
 }
 
+func (m *queryManager) setArgs(args []any) *queryManager {
+	m.args = args
+	return m
+}
+
 func (m *queryManager) setQueryType(queryType string) *queryManager {
 	m.queryType = queryType
 	return m
 }
 
 func (m *queryManager) setDest(dest any) *queryManager {
+	m.dest = dest
+	if dest == nil {
+		return m
+	}
 	t := reflect.TypeOf(dest)
 	v := reflect.ValueOf(dest)
 	if t.Kind() == reflect.Ptr {
@@ -51,130 +62,277 @@ func (m *queryManager) setDest(dest any) *queryManager {
 	if v.Kind() == reflect.Ptr {
 		v = v.Elem()
 	}
-	m.dest = dest
 	m.destRef = ref{t: t, v: v}
 	m.resultType = v.Kind().String()
 	return m
 }
 
+func (m *queryManager) setCheckStale(checkStale bool) *queryManager {
+	m.checkStale = checkStale
+	return m
+}
+
+func (m *queryManager) setAfterFind(afterFind func(dest any) error) *queryManager {
+	m.afterFind = afterFind
+	return m
+}
+
 func (m *queryManager) getResult() {
 	// defer m.entity.errorHandler.recover()
 	m.log()
-	m.scan()
+	m.entity.errorManager.check(classifyError(m.scan()), m.query)
+	m.entity.errorManager.check(m.runAfterFind(m.dest), "")
+}
+
+func (m *queryManager) getResultE() error {
+	m.log()
+	if err := m.scan(); err != nil {
+		return m.createQueryError(err)
+	}
+	return m.runAfterFind(m.dest)
+}
+
+func (m *queryManager) runAfterFind(dest any) error {
+	if m.afterFind == nil {
+		return nil
+	}
+	return m.afterFind(dest)
 }
 
 func (m *queryManager) exec() {
 	// defer m.entity.errorHandler.recover()
 	m.log()
-	_, err := m.connection().Exec(m.query)
-	m.entity.errorManager.check(err, m.query)
+	_, err := m.run()
+	m.entity.errorManager.check(classifyError(err), m.query)
 }
 
-func (m *queryManager) getRowData(row *sql.Rows, columnsTypes []*sql.ColumnType) (reflect.Value, error) {
-	result := m.createRowDataModel()
-	model := make([]any, len(columnsTypes))
-	columns := make([]string, len(columnsTypes))
-	for i, ct := range columnsTypes {
-		columns[i] = strcase.ToCamel(ct.Name())
-		typeName := strings.ToLower(ct.DatabaseTypeName())
-		if strings.HasPrefix(typeName, "_") {
-			model[i] = &sql.NullString{}
-			continue
+func (m *queryManager) execE() (sql.Result, error) {
+	m.log()
+	result, err := m.run()
+	return result, m.createQueryError(err)
+}
+
+func (m *queryManager) getRows(fetchSize int) (Rows, error) {
+	if fetchSize <= 0 {
+		m.log()
+		rows, err := m.connection().QueryContext(m.context, m.query, m.args...)
+		if err != nil {
+			return nil, m.createQueryError(err)
 		}
-		switch typeName {
-		case Varchar, Char, Text:
-			model[i] = &sql.NullString{}
-		case Int4, Int8:
-			model[i] = &sql.NullInt64{}
-		case Float4, Float8:
-			model[i] = &sql.NullFloat64{}
-		case Bool, Boolean:
-			model[i] = &sql.NullBool{}
-		case Byte, Bytea:
-			model[i] = &sql.NullByte{}
-		case Timestamp, TimestampWithZone:
-			model[i] = &sql.NullTime{}
+		result, err := createResultRows(m, rows, nil)
+		if err != nil {
+			return nil, m.createQueryError(err)
 		}
+		return result, nil
 	}
-	if err := row.Scan(model...); err != nil {
+	cursor, err := createServerCursor(m.context, m.entity.land, fetchSize)
+	if err != nil {
+		return nil, m.createQueryError(err)
+	}
+	query := m.query
+	m.setQuery(cursor.createDeclareQuery(query))
+	m.log()
+	if err := cursor.declare(query, m.args); err != nil {
+		return nil, m.createQueryError(err)
+	}
+	rows, err := cursor.fetch()
+	if err != nil {
+		return nil, m.createQueryError(errors.Join(err, cursor.close(true)))
+	}
+	result, err := createResultRows(m, rows, cursor)
+	if err != nil {
+		return nil, m.createQueryError(errors.Join(err, cursor.close(true)))
+	}
+	return result, nil
+}
+
+func (m *queryManager) run() (sql.Result, error) {
+	result, err := m.connection().ExecContext(m.context, m.query, m.args...)
+	if err != nil || !m.checkStale {
 		return result, err
 	}
-	for i, c := range columns {
-		switch modelValue := model[i].(type) {
-		case *sql.NullFloat64:
-			m.setValue(result, c, modelValue.Float64)
-		case *sql.NullInt64:
-			m.setValue(result, c, int(modelValue.Int64))
-		case *sql.NullString:
-			if result.Kind() == reflect.Slice && strings.HasPrefix(
-				modelValue.String, "{",
-			) && strings.HasSuffix(modelValue.String, "}") {
-				m.setValue(result, c, m.createSliceFromArrayAgg(modelValue.String))
-			} else {
-				m.setValue(result, c, modelValue.String)
+	count, err := result.RowsAffected()
+	if err == nil && count == 0 {
+		err = ErrStaleObject
+	}
+	return result, err
+}
+
+func (m *queryManager) createQueryError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &QueryError{
+		Err:       classifyError(err),
+		SQL:       m.query,
+		Args:      m.args,
+		QueryType: m.queryType,
+	}
+}
+
+func (m *queryManager) createRowMapping(columnsTypes []*sql.ColumnType) *rowMapping {
+	result := &rowMapping{
+		columns: make([]*columnMapping, len(columnsTypes)),
+		targets: make([]any, len(columnsTypes)),
+	}
+	for i, ct := range columnsTypes {
+		result.columns[i] = createColumnMapping(ct.Name(), strings.ToLower(ct.DatabaseTypeName()))
+		result.targets[i] = m.createScanTarget(result.columns[i].typeName)
+	}
+	return result
+}
+
+func (m *queryManager) getRowData(row *sql.Rows, mapping *rowMapping) (reflect.Value, error) {
+	result := m.createRowDataModel()
+	if err := row.Scan(mapping.targets...); err != nil {
+		return result, err
+	}
+	for i, c := range mapping.columns {
+		value, err := m.getScannedValue(c.typeName, mapping.targets[i])
+		if err != nil {
+			return result, fmt.Errorf("land: decode column %s: %w", c.name, err)
+		}
+		if array, ok := value.(arrayLiteral); ok && m.isDestArrayAgg() {
+			slice, err := m.createSliceFromArrayAgg(array.text)
+			if err != nil {
+				return result, err
 			}
-		case *sql.NullBool:
-			m.setValue(result, c, modelValue.Bool)
-		case *sql.NullByte:
-			m.setValue(result, c, modelValue.Byte)
-		case *sql.NullTime:
-			m.setValue(result, c, modelValue.Time)
+			result = reflect.ValueOf(slice)
+			continue
+		}
+		if err := m.setColumnValue(result, c, value); err != nil {
+			return result, fmt.Errorf("land: assign column %s: %w", c.name, err)
 		}
 	}
 	return result, nil
 }
 
-func (m *queryManager) createSliceFromArrayAgg(value string) any {
-	items := strings.Split(strings.TrimSuffix(strings.TrimPrefix(value, "{"), "}"), ",")
-	result := reflect.New(m.destRef.t).Elem()
-	if len(items) == 0 {
-		return result.Interface()
-	}
-	sliceElemKind := m.destRef.t.Elem().Kind()
-	for _, item := range items {
-		switch sliceElemKind {
-		case reflect.Float32:
-			result = reflect.Append(result, reflect.ValueOf(numx.Float32(item)))
-		case reflect.Float64:
-			result = reflect.Append(result, reflect.ValueOf(numx.Float64(item)))
-		case reflect.Int:
-			result = reflect.Append(result, reflect.ValueOf(numx.Int(item)))
-		case reflect.Bool:
-			result = reflect.Append(result, reflect.ValueOf(item == "true"))
-		default:
-			result = reflect.Append(result, reflect.ValueOf(item))
+func (m *queryManager) createScanTarget(typeName string) any {
+	if getTypeCodec(typeName) != nil {
+		return new(any)
+	}
+	switch typeName {
+	case Varchar, Char, Text:
+		return &sql.NullString{}
+	case Int2, Int4, Int8:
+		return &sql.NullInt64{}
+	case Float4, Float8:
+		return &sql.NullFloat64{}
+	case Bool, Boolean:
+		return &sql.NullBool{}
+	case Timestamp, TimestampWithZone:
+		return &sql.NullTime{}
+	default:
+		return new(any)
+	}
+}
+
+func (m *queryManager) getScannedValue(typeName string, model any) (any, error) {
+	switch modelValue := model.(type) {
+	case *sql.NullFloat64:
+		if modelValue.Valid {
+			return modelValue.Float64, nil
+		}
+	case *sql.NullInt64:
+		if modelValue.Valid {
+			return int(modelValue.Int64), nil
 		}
+	case *sql.NullString:
+		if modelValue.Valid {
+			return modelValue.String, nil
+		}
+	case *sql.NullBool:
+		if modelValue.Valid {
+			return modelValue.Bool, nil
+		}
+	case *sql.NullTime:
+		if modelValue.Valid {
+			return modelValue.Time, nil
+		}
+	case *any:
+		return m.decodeRawValue(typeName, *modelValue)
 	}
-	return result.Interface()
+	return nil, nil
 }
 
-func (m *queryManager) setValue(model reflect.Value, key string, value any) {
+func (m *queryManager) decodeRawValue(typeName string, value any) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+	if codec := getTypeCodec(typeName); codec != nil {
+		return codec.Decode(value)
+	}
+	if b, ok := value.([]byte); ok && strings.HasPrefix(typeName, "_") {
+		return createArrayLiteral(typeName, string(b)), nil
+	}
+	if b, ok := value.([]byte); ok && isJsonType(typeName) {
+		return json.RawMessage(b), nil
+	}
+	if b, ok := value.([]byte); ok && typeName != Bytea {
+		return string(b), nil
+	}
+	return value, nil
+}
+
+func (m *queryManager) createSliceFromArrayAgg(value string) (any, error) {
+	result, err := decodeArray(m.destRef.t, value)
+	if err != nil {
+		return nil, err
+	}
+	return result.Interface(), nil
+}
+
+func (m *queryManager) setValue(model reflect.Value, key string, value any) error {
+	return m.setColumnValue(model, createColumnMapping(key, ""), value)
+}
+
+func (m *queryManager) setColumnValue(model reflect.Value, c *columnMapping, value any) error {
 	v := reflect.ValueOf(value)
-	if v.Kind() == reflect.Slice {
+	if v.Kind() == reflect.Slice && model.Kind() == reflect.Slice {
 		model.Set(v)
-		return
+		return nil
 	}
 	if m.isDestMap() || m.isDestSliceOfMaps() {
-		model.SetMapIndex(reflect.ValueOf(key), v)
-		return
+		if array, ok := value.(arrayLiteral); ok {
+			decoded, err := array.decode()
+			if err != nil {
+				return err
+			}
+			v = reflect.ValueOf(decoded)
+		}
+		if raw, ok := value.(json.RawMessage); ok {
+			decoded, err := decodeJson(raw)
+			if err != nil {
+				return err
+			}
+			v = reflect.ValueOf(decoded)
+		}
+		if !v.IsValid() {
+			v = reflect.Zero(model.Type().Elem())
+		}
+		model.SetMapIndex(c.mapKey, v)
+		return nil
 	}
-	if model.Kind() != reflect.Struct {
-		model.Set(v)
-		return
+	field, isStruct := c.getField(model.Type())
+	if !isStruct {
+		return assignValue(model, value)
 	}
-	m.setValueToStruct(model, key, v)
+	return m.setStructField(model, field, value)
 }
 
-func (m *queryManager) setValueToStruct(model reflect.Value, key string, value reflect.Value) {
-	f := model.FieldByName(key)
-	if !f.IsValid() || !value.IsValid() {
-		return
+func (m *queryManager) setValueToStruct(model reflect.Value, key string, value any) error {
+	return m.setStructField(model, getStructFields(model.Type()).get(key), value)
+}
+
+func (m *queryManager) setStructField(model reflect.Value, structField *structField, value any) error {
+	if structField == nil {
+		return nil
 	}
-	if f.Kind() != value.Kind() {
-		fmt.Printf("%s: mismatch data types\n", key)
-		return
+	f := structField.write(model)
+	if !f.IsValid() {
+		return nil
 	}
-	f.Set(value)
+	return structField.assign(f, value)
 }
 
 func (m *queryManager) createScanRowColumn(columnType string) any {
@@ -191,54 +349,68 @@ func (m *queryManager) createScanRowColumn(columnType string) any {
 	return new(any)
 }
 
-func (m *queryManager) scan() {
+func (m *queryManager) scan() (err error) {
+	if !m.destRef.v.IsValid() || !m.destRef.v.CanSet() {
+		return ErrInvalidDest
+	}
 	start := time.Now()
-	rows, err := m.connection().QueryContext(m.context, m.query)
-	m.entity.errorManager.check(err, m.query)
+	rows, err := m.connection().QueryContext(m.context, m.query, m.args...)
+	if err != nil {
+		return err
+	}
 	m.duration = time.Now().Sub(start)
 	defer func() {
-		m.entity.errorManager.check(rows.Close(), m.query)
+		if closeErr := rows.Close(); err == nil {
+			err = closeErr
+		}
 	}()
 	columnsTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return err
+	}
+	mapping := m.createRowMapping(columnsTypes)
 	for rows.Next() {
-		rowData, err := m.getRowData(rows, columnsTypes)
+		m.rowsCount++
+		rowData, err := m.getRowData(rows, mapping)
 		if err != nil {
-			panic(
-				Error{
-					Error: err,
-					Query: m.query,
-				},
-			)
+			return err
 		}
 		m.setRowDataToResult(rowData)
 	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if m.checkStale && m.rowsCount == 0 {
+		return ErrStaleObject
+	}
+	return nil
 }
 
 func (m *queryManager) createRowDataModel() reflect.Value {
 	var result reflect.Value
 	if !m.isDestSlice() {
+		if m.isDestMap() && m.destRef.v.IsNil() {
+			m.destRef.v.Set(reflect.MakeMap(m.destRef.t))
+		}
 		result = m.destRef.v
 		return result
 	}
 	switch m.destRef.t.Elem().Kind() {
 	case reflect.Map:
 		result = reflect.MakeMapWithSize(m.destRef.t.Elem(), 0)
-	case reflect.Struct:
-		result = reflect.New(m.destRef.t.Elem()).Elem()
 	default:
-		result = reflect.New(m.destRef.t).Elem()
+		result = reflect.New(m.destRef.t.Elem()).Elem()
 	}
 	return result
 }
 
 func (m *queryManager) setRowDataToResult(rowData reflect.Value) {
 	if m.isDestSlice() {
-		if rowData.Kind() == reflect.Slice {
+		if rowData.Type() == m.destRef.t {
 			m.destRef.v.Set(rowData)
+			return
 		}
-		if rowData.Kind() == reflect.Struct || rowData.Kind() == reflect.Map {
-			m.destRef.v.Set(reflect.Append(m.destRef.v, rowData))
-		}
+		m.destRef.v.Set(reflect.Append(m.destRef.v, rowData))
 		return
 	}
 	m.destRef.v.Set(rowData)
@@ -256,17 +428,33 @@ func (m *queryManager) isDestSliceOfMaps() bool {
 	return m.isDestSlice() && m.destRef.t.Elem().Kind() == reflect.Map
 }
 
+func (m *queryManager) isDestArrayAgg() bool {
+	if !m.isDestSlice() {
+		return false
+	}
+	switch m.destRef.t.Elem().Kind() {
+	case reflect.Struct, reflect.Map, reflect.Slice:
+		return false
+	default:
+		return true
+	}
+}
+
 func (m *queryManager) isDestSliceOfStructs() bool {
 	return m.isDestSlice() && m.destRef.t.Elem().Kind() == reflect.Struct
 }
 
-func (m *queryManager) connection() *sql.DB {
-	return m.entity.land.db.connection
+func (m *queryManager) connection() executor {
+	return m.entity.connection()
 }
 
 func (m *queryManager) log() {
 	if !m.entity.land.config.Log {
 		return
 	}
-	fmt.Println(fmt.Sprintf("%s in %v: %s", strings.ToUpper(m.queryType), m.duration, m.query))
+	if len(m.args) > 0 {
+		m.entity.land.logger().Println(fmt.Sprintf("%s in %v: %s %v", strings.ToUpper(m.queryType), m.duration, m.query, m.args))
+		return
+	}
+	m.entity.land.logger().Println(fmt.Sprintf("%s in %v: %s", strings.ToUpper(m.queryType), m.duration, m.query))
 }
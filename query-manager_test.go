@@ -0,0 +1,34 @@
+package land
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArrayAggParse(t *testing.T) {
+	test := assert.New(t)
+	var result []int
+	m := createQueryManager(nil, nil).setDest(&result)
+	slice, err := m.createSliceFromArrayAgg("{1,2,3}")
+	test.NoError(err)
+	test.Equal([]int{1, 2, 3}, slice)
+	slice, err = m.createSliceFromArrayAgg("{}")
+	test.NoError(err)
+	test.Equal([]int(nil), slice)
+	_, err = m.createSliceFromArrayAgg("{1,x}")
+	test.Error(err)
+}
+
+func TestScanAssignError(t *testing.T) {
+	test := assert.New(t)
+	l, fake := testFakeLand(t)
+	fake.setResult(testEntityName, []string{testName, testActive}, []driver.Value{"Dominik", "yes"})
+	result := make([]testModel, 0)
+	err := testEntity(l).Select().ScanE(context.Background(), &result)
+	test.ErrorContains(err, "assign column active")
+	var query *QueryError
+	test.ErrorAs(err, &query)
+}
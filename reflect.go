@@ -1,6 +1,15 @@
 package land
 
-import "reflect"
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+type valueAssigner func(dest reflect.Value, value any) error
 
 type ref struct {
 	v    reflect.Value
@@ -8,3 +17,219 @@ type ref struct {
 	kind reflect.Kind
 	safe bool
 }
+
+func createDataRef(v reflect.Value) ref {
+	if !v.IsValid() {
+		return ref{}
+	}
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			break
+		}
+		v = v.Elem()
+	}
+	return ref{v: v, t: v.Type(), kind: v.Kind()}
+}
+
+var (
+	scannerType = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+	valuerType  = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+)
+
+func assignValue(dest reflect.Value, value any) error {
+	if dest.CanAddr() && dest.Addr().Type().Implements(scannerType) {
+		return dest.Addr().Interface().(sql.Scanner).Scan(createDriverValue(value))
+	}
+	if value == nil {
+		dest.Set(reflect.Zero(dest.Type()))
+		return nil
+	}
+	if dest.Kind() == reflect.Ptr {
+		elem := reflect.New(dest.Type().Elem())
+		if err := assignValue(elem.Elem(), value); err != nil {
+			return err
+		}
+		dest.Set(elem)
+		return nil
+	}
+	src := reflect.ValueOf(value)
+	if src.Type().AssignableTo(dest.Type()) {
+		dest.Set(src)
+		return nil
+	}
+	if raw, ok := value.(json.RawMessage); ok {
+		return assignJson(dest, raw)
+	}
+	if array, ok := value.(arrayLiteral); ok {
+		return assignArray(dest, array)
+	}
+	if b, ok := value.([]byte); ok && dest.Kind() == reflect.String {
+		dest.SetString(string(b))
+		return nil
+	}
+	if isConvertibleKind(src.Kind(), dest.Kind()) && src.Type().ConvertibleTo(dest.Type()) {
+		dest.Set(src.Convert(dest.Type()))
+		return nil
+	}
+	return fmt.Errorf("land: cannot assign %T to %s", value, dest.Type())
+}
+
+func createValueAssigner(t reflect.Type) valueAssigner {
+	if reflect.PointerTo(t).Implements(scannerType) {
+		return func(dest reflect.Value, value any) error {
+			return dest.Addr().Interface().(sql.Scanner).Scan(createDriverValue(value))
+		}
+	}
+	switch {
+	case t.Kind() == reflect.String:
+		return func(dest reflect.Value, value any) error {
+			if v, ok := value.(string); ok {
+				dest.SetString(v)
+				return nil
+			}
+			return assignValue(dest, value)
+		}
+	case isIntKind(t.Kind()):
+		return func(dest reflect.Value, value any) error {
+			if v, ok := value.(int); ok && dest.CanInt() {
+				dest.SetInt(int64(v))
+				return nil
+			}
+			return assignValue(dest, value)
+		}
+	case isFloatKind(t.Kind()):
+		return func(dest reflect.Value, value any) error {
+			if v, ok := value.(float64); ok {
+				dest.SetFloat(v)
+				return nil
+			}
+			return assignValue(dest, value)
+		}
+	case t.Kind() == reflect.Bool:
+		return func(dest reflect.Value, value any) error {
+			if v, ok := value.(bool); ok {
+				dest.SetBool(v)
+				return nil
+			}
+			return assignValue(dest, value)
+		}
+	case t == timeType:
+		return func(dest reflect.Value, value any) error {
+			if v, ok := value.(time.Time); ok && dest.CanAddr() {
+				*dest.Addr().Interface().(*time.Time) = v
+				return nil
+			}
+			return assignValue(dest, value)
+		}
+	default:
+		return assignValue
+	}
+}
+
+func isConvertibleKind(src, dest reflect.Kind) bool {
+	switch {
+	case src == reflect.String && dest == reflect.String:
+		return true
+	case src == reflect.Bool && dest == reflect.Bool:
+		return true
+	case isIntKind(src) && isIntKind(dest):
+		return true
+	case isFloatKind(src) && isFloatKind(dest):
+		return true
+	case isIntKind(src) && isFloatKind(dest):
+		return true
+	default:
+		return false
+	}
+}
+
+func isIntKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	default:
+		return false
+	}
+}
+
+func getIntValue(value reflect.Value) any {
+	if value.CanInt() {
+		return value.Int()
+	}
+	return value.Uint()
+}
+
+func isFloatKind(kind reflect.Kind) bool {
+	return kind == reflect.Float32 || kind == reflect.Float64
+}
+
+func isNullValue(value reflect.Value) bool {
+	if !value.IsValid() {
+		return true
+	}
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice:
+		if value.IsNil() {
+			return true
+		}
+	}
+	if value.Type().Implements(valuerType) {
+		v, err := value.Interface().(driver.Valuer).Value()
+		return err == nil && v == nil
+	}
+	return false
+}
+
+func createDriverValue(value any) any {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case arrayLiteral:
+		return []byte(v.text)
+	case json.RawMessage:
+		return []byte(v)
+	}
+	v, err := driver.DefaultParameterConverter.ConvertValue(value)
+	if err != nil {
+		return value
+	}
+	return v
+}
+
+func assignJson(dest reflect.Value, raw json.RawMessage) error {
+	if dest.Kind() == reflect.String {
+		dest.SetString(string(raw))
+		return nil
+	}
+	target := reflect.New(dest.Type())
+	if err := json.Unmarshal(raw, target.Interface()); err != nil {
+		return err
+	}
+	dest.Set(target.Elem())
+	return nil
+}
+
+func assignArray(dest reflect.Value, array arrayLiteral) error {
+	switch dest.Kind() {
+	case reflect.String:
+		dest.SetString(array.text)
+		return nil
+	case reflect.Slice:
+		result, err := decodeArray(dest.Type(), array.text)
+		if err != nil {
+			return err
+		}
+		dest.Set(result)
+		return nil
+	case reflect.Interface:
+		result, err := array.decode()
+		if err != nil {
+			return err
+		}
+		dest.Set(reflect.ValueOf(result))
+		return nil
+	default:
+		return fmt.Errorf("land: cannot assign array to %s", dest.Type())
+	}
+}
@@ -0,0 +1,279 @@
+package land
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/iancoleman/strcase"
+)
+
+type relation struct {
+	name         string
+	relationType string
+	entity       *entity
+	pivot        *entity
+	foreignKey   string
+	relatedKey   string
+}
+
+const (
+	relationHasMany    = "hasMany"
+	relationBelongsTo  = "belongsTo"
+	relationManyToMany = "manyToMany"
+)
+
+var (
+	ErrUnknownRelation = errors.New("land: unknown relation")
+	ErrRelationField   = errors.New("land: destination has no field for relation")
+	ErrPreloadRows     = errors.New("land: preload is not supported for rows")
+)
+
+func (e *entity) getRelation(name string) *relation {
+	for _, r := range e.relations {
+		if r.name == name {
+			return r
+		}
+	}
+	return nil
+}
+
+func preloadRelations(ctx context.Context, e *entity, dest any, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+	parents := getRelationParents(dest)
+	if len(parents) == 0 {
+		return nil
+	}
+	for _, name := range names {
+		r := e.getRelation(name)
+		if r == nil {
+			return fmt.Errorf("%w: %s", ErrUnknownRelation, name)
+		}
+		field := getStructFields(parents[0].Type()).get(r.name)
+		if field == nil || !r.isFieldKind(field.write(parents[0]).Kind()) {
+			return fmt.Errorf("%w: %s", ErrRelationField, r.name)
+		}
+		if err := r.load(ctx, e.land, parents, field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func getRelationParents(dest any) []reflect.Value {
+	v := reflect.ValueOf(dest)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	values := []reflect.Value{v}
+	if v.Kind() == reflect.Slice {
+		values = make([]reflect.Value, v.Len())
+		for i := range values {
+			values[i] = v.Index(i)
+		}
+	}
+	result := make([]reflect.Value, 0, len(values))
+	for _, value := range values {
+		if value.Kind() == reflect.Ptr && !value.IsNil() {
+			value = value.Elem()
+		}
+		if value.Kind() == reflect.Struct && value.CanAddr() {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+func (r *relation) isFieldKind(kind reflect.Kind) bool {
+	if r.relationType == relationBelongsTo {
+		return kind == reflect.Ptr || kind == reflect.Struct
+	}
+	return kind == reflect.Slice
+}
+
+func (r *relation) load(ctx context.Context, l *land, parents []reflect.Value, field *structField) error {
+	switch r.relationType {
+	case relationHasMany:
+		return r.loadHasMany(ctx, l, parents, field)
+	case relationBelongsTo:
+		return r.loadBelongsTo(ctx, l, parents, field)
+	case relationManyToMany:
+		return r.loadManyToMany(ctx, l, parents, field)
+	default:
+		return nil
+	}
+}
+
+func (r *relation) loadHasMany(ctx context.Context, l *land, parents []reflect.Value, field *structField) error {
+	elemType := field.write(parents[0]).Type().Elem()
+	children, err := r.selectRelated(ctx, l, r.entity, r.foreignKey, getRelationKeys(parents, Id), elemType)
+	if err != nil {
+		return err
+	}
+	grouped := groupRelationValues(children, r.foreignKey)
+	for _, parent := range parents {
+		key, _ := readRelationKey(parent, Id)
+		setRelationSlice(field.write(parent), grouped[key])
+	}
+	return nil
+}
+
+func (r *relation) loadBelongsTo(ctx context.Context, l *land, parents []reflect.Value, field *structField) error {
+	targetType := field.write(parents[0]).Type()
+	children, err := r.selectRelated(ctx, l, r.entity, Id, getRelationKeys(parents, r.foreignKey), targetType)
+	if err != nil {
+		return err
+	}
+	grouped := groupRelationValues(children, Id)
+	for _, parent := range parents {
+		key, ok := readRelationKey(parent, r.foreignKey)
+		if !ok || len(grouped[key]) == 0 {
+			continue
+		}
+		field.write(parent).Set(convertRelationValue(grouped[key][0], targetType))
+	}
+	return nil
+}
+
+func (r *relation) loadManyToMany(ctx context.Context, l *land, parents []reflect.Value, field *structField) error {
+	pivots := make([]map[string]any, 0)
+	for _, keys := range chunkRelationKeys(getRelationKeys(parents, Id)) {
+		chunk := make([]map[string]any, 0)
+		q := r.pivot.withLand(l).Select().All()
+		q.Columns(r.foreignKey, r.relatedKey)
+		q.Where().Column(r.foreignKey).Contains(keys)
+		if err := q.ScanE(ctx, &chunk); err != nil {
+			return err
+		}
+		pivots = append(pivots, chunk...)
+	}
+	foreignKey, relatedKey := strcase.ToCamel(r.foreignKey), strcase.ToCamel(r.relatedKey)
+	relatedKeys := make([]any, 0)
+	for _, pivot := range pivots {
+		relatedKeys = append(relatedKeys, pivot[relatedKey])
+	}
+	elemType := field.write(parents[0]).Type().Elem()
+	children, err := r.selectRelated(ctx, l, r.entity, Id, uniqueRelationKeys(relatedKeys), elemType)
+	if err != nil {
+		return err
+	}
+	related := groupRelationValues(children, Id)
+	grouped := make(map[string][]reflect.Value)
+	for _, pivot := range pivots {
+		key := fmt.Sprint(pivot[foreignKey])
+		grouped[key] = append(grouped[key], related[fmt.Sprint(pivot[relatedKey])]...)
+	}
+	for _, parent := range parents {
+		key, _ := readRelationKey(parent, Id)
+		setRelationSlice(field.write(parent), grouped[key])
+	}
+	return nil
+}
+
+func (r *relation) selectRelated(ctx context.Context, l *land, e *entity, column string, keys []any, elemType reflect.Type) (reflect.Value, error) {
+	if elemType.Kind() == reflect.Ptr {
+		elemType = elemType.Elem()
+	}
+	result := reflect.MakeSlice(reflect.SliceOf(elemType), 0, 0)
+	for _, chunk := range chunkRelationKeys(keys) {
+		values := reflect.New(result.Type())
+		q := e.withLand(l).Select().All()
+		q.Where().Column(column).Contains(chunk)
+		if err := q.ScanE(ctx, values.Interface()); err != nil {
+			return result, err
+		}
+		result = reflect.AppendSlice(result, values.Elem())
+	}
+	return result, nil
+}
+
+func chunkRelationKeys(keys []any) [][]any {
+	result := make([][]any, 0)
+	for len(keys) > maxQueryArgs {
+		result = append(result, keys[:maxQueryArgs])
+		keys = keys[maxQueryArgs:]
+	}
+	if len(keys) > 0 {
+		result = append(result, keys)
+	}
+	return result
+}
+
+func getRelationKeys(values []reflect.Value, column string) []any {
+	result := make([]any, 0, len(values))
+	for _, v := range values {
+		if key, ok := readRelationValue(v, column); ok {
+			result = append(result, key)
+		}
+	}
+	return uniqueRelationKeys(result)
+}
+
+func uniqueRelationKeys(keys []any) []any {
+	result := make([]any, 0, len(keys))
+	seen := make(map[string]bool)
+	for _, key := range keys {
+		if key == nil || seen[fmt.Sprint(key)] {
+			continue
+		}
+		seen[fmt.Sprint(key)] = true
+		result = append(result, key)
+	}
+	return result
+}
+
+func groupRelationValues(values reflect.Value, column string) map[string][]reflect.Value {
+	result := make(map[string][]reflect.Value)
+	for i := 0; i < values.Len(); i++ {
+		v := values.Index(i)
+		key, ok := readRelationKey(v, column)
+		if !ok {
+			continue
+		}
+		result[key] = append(result[key], v)
+	}
+	return result
+}
+
+func readRelationKey(v reflect.Value, column string) (string, bool) {
+	value, ok := readRelationValue(v, column)
+	return fmt.Sprint(value), ok
+}
+
+func readRelationValue(v reflect.Value, column string) (any, bool) {
+	if v.Kind() != reflect.Struct {
+		return nil, false
+	}
+	field := getStructFields(v.Type()).get(column)
+	if field == nil {
+		return nil, false
+	}
+	value := field.read(v)
+	if !value.IsValid() || isNullValue(value) {
+		return nil, false
+	}
+	if valuer, ok := value.Interface().(driver.Valuer); ok {
+		result, err := valuer.Value()
+		return result, err == nil && result != nil
+	}
+	return reflect.Indirect(value).Interface(), true
+}
+
+func setRelationSlice(field reflect.Value, values []reflect.Value) {
+	result := reflect.MakeSlice(field.Type(), 0, len(values))
+	for _, v := range values {
+		result = reflect.Append(result, convertRelationValue(v, field.Type().Elem()))
+	}
+	field.Set(result)
+}
+
+func convertRelationValue(v reflect.Value, t reflect.Type) reflect.Value {
+	if t.Kind() == reflect.Ptr {
+		return v.Addr()
+	}
+	return v
+}
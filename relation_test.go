@@ -0,0 +1,134 @@
+package land
+
+import (
+	"context"
+	"database/sql/driver"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testRelationUser struct {
+	Id       int
+	AuthorId int
+	Posts    []testRelationPost
+	Author   *testRelationNamed
+	Tags     []*testRelationNamed
+}
+
+type testRelationPost struct {
+	Id     int
+	UserId int
+	Title  string
+}
+
+type testRelationNamed struct {
+	Id   int
+	Name string
+}
+
+func testRelationEntity(l Land) Entity {
+	posts := l.CreateEntity("posts").SetAlias("p").SetColumn("user_id", Int).SetColumn("title", Varchar)
+	authors := l.CreateEntity("authors").SetAlias("a").SetColumn(testName, Varchar)
+	tags := l.CreateEntity("tags").SetAlias("tg").SetColumn(testName, Varchar)
+	pivot := l.CreateEntity("users_tags").SetAlias("ut").SetColumn("user_id", Int).SetColumn("tag_id", Int)
+	return testEntity(l).
+		SetColumn("author_id", Int).
+		HasMany("posts", posts, "user_id").
+		BelongsTo("author", authors, "author_id").
+		ManyToMany("tags", tags, pivot, "user_id", "tag_id")
+}
+
+func testRelationLand(t *testing.T) (Land, *testFakeDB) {
+	l, fake := testFakeLand(t)
+	fake.setResult("tests", []string{Id, "author_id"}, []driver.Value{int64(1), int64(20)}, []driver.Value{int64(2), int64(20)})
+	fake.setResult(
+		"posts",
+		[]string{Id, "user_id", "title"},
+		[]driver.Value{int64(10), int64(1), "First"},
+		[]driver.Value{int64(11), int64(2), "Second"},
+		[]driver.Value{int64(12), int64(2), "Third"},
+	)
+	fake.setResult("authors", []string{Id, testName}, []driver.Value{int64(20), "Dominik"})
+	fake.setResult(
+		"users_tags",
+		[]string{"user_id", "tag_id"},
+		[]driver.Value{int64(1), int64(30)},
+		[]driver.Value{int64(2), int64(30)},
+		[]driver.Value{int64(2), int64(31)},
+	)
+	fake.setResult("tags", []string{Id, testName}, []driver.Value{int64(30), "go"}, []driver.Value{int64(31), "sql"})
+	return l, fake
+}
+
+func TestPreloadRelations(t *testing.T) {
+	test := assert.New(t)
+	l, fake := testRelationLand(t)
+	result := make([]testRelationUser, 0)
+	q := testRelationEntity(l).Select().Preload("posts", "author", "tags")
+	test.NoError(q.ScanE(context.Background(), &result))
+	test.Equal(
+		[]string{
+			`SELECT * FROM "tests" AS "t" LIMIT 20;`,
+			`SELECT * FROM "posts" AS "p" WHERE "p"."user_id" IN ($1,$2);`,
+			`SELECT * FROM "authors" AS "a" WHERE "a"."id" IN ($1);`,
+			`SELECT "ut"."user_id","ut"."tag_id" FROM "users_tags" AS "ut" WHERE "ut"."user_id" IN ($1,$2);`,
+			`SELECT * FROM "tags" AS "tg" WHERE "tg"."id" IN ($1,$2);`,
+		},
+		fake.getQueries(),
+	)
+	test.Len(result, 2)
+	test.Equal([]testRelationPost{{Id: 10, UserId: 1, Title: "First"}}, result[0].Posts)
+	test.Equal([]testRelationPost{{Id: 11, UserId: 2, Title: "Second"}, {Id: 12, UserId: 2, Title: "Third"}}, result[1].Posts)
+	test.Equal(&testRelationNamed{Id: 20, Name: "Dominik"}, result[0].Author)
+	test.Equal(&testRelationNamed{Id: 20, Name: "Dominik"}, result[1].Author)
+	test.Equal([]*testRelationNamed{{Id: 30, Name: "go"}}, result[0].Tags)
+	test.Equal([]*testRelationNamed{{Id: 30, Name: "go"}, {Id: 31, Name: "sql"}}, result[1].Tags)
+}
+
+func TestPreloadRelationsChunked(t *testing.T) {
+	test := assert.New(t)
+	l, fake := testRelationLand(t)
+	r := testRelationEntity(l).getPtr().getRelation("posts")
+	keys := make([]any, maxQueryArgs+1)
+	for i := range keys {
+		keys[i] = i
+	}
+	values, err := r.selectRelated(context.Background(), l.getPtr(), r.entity, "user_id", keys, reflect.TypeOf(testRelationPost{}))
+	test.NoError(err)
+	test.Equal(6, values.Len())
+	queries := fake.getQueries()
+	test.Len(queries, 2)
+	test.Equal(`SELECT * FROM "posts" AS "p" WHERE "p"."user_id" IN ($1);`, queries[1])
+}
+
+func TestPreloadUnknownRelation(t *testing.T) {
+	test := assert.New(t)
+	l, _ := testRelationLand(t)
+	result := make([]testRelationUser, 0)
+	err := testRelationEntity(l).Select().Preload("comments").ScanE(context.Background(), &result)
+	test.ErrorIs(err, ErrUnknownRelation)
+}
+
+func TestPreloadRows(t *testing.T) {
+	test := assert.New(t)
+	l, _ := testRelationLand(t)
+	rows, err := testRelationEntity(l).Select().Preload("posts").Cursor(100).Rows(context.Background())
+	test.Nil(rows)
+	test.ErrorIs(err, ErrPreloadRows)
+}
+
+func TestPreloadRelationFieldKind(t *testing.T) {
+	test := assert.New(t)
+	result := make([]struct {
+		Id     int
+		Posts  string
+		Author []testScanModel
+	}, 0)
+	l, _ := testRelationLand(t)
+	q := testRelationEntity(l).Select().Preload("posts")
+	test.ErrorIs(q.ScanE(context.Background(), &result), ErrRelationField)
+	q = testRelationEntity(l).Select().Preload("author")
+	test.ErrorIs(q.ScanE(context.Background(), &result), ErrRelationField)
+}
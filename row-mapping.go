@@ -0,0 +1,42 @@
+package land
+
+import (
+	"reflect"
+
+	"github.com/iancoleman/strcase"
+)
+
+type rowMapping struct {
+	columns []*columnMapping
+	targets []any
+}
+
+type columnMapping struct {
+	name      string
+	typeName  string
+	mapKey    reflect.Value
+	modelType reflect.Type
+	field     *structField
+	isStruct  bool
+}
+
+func createColumnMapping(name, typeName string) *columnMapping {
+	return &columnMapping{
+		name:     name,
+		typeName: typeName,
+		mapKey:   reflect.ValueOf(strcase.ToCamel(name)),
+	}
+}
+
+func (c *columnMapping) getField(t reflect.Type) (*structField, bool) {
+	if c.modelType == t {
+		return c.field, c.isStruct
+	}
+	c.modelType = t
+	c.isStruct = t.Kind() == reflect.Struct && isStructType(t)
+	c.field = nil
+	if c.isStruct {
+		c.field = getStructFields(t).get(c.name)
+	}
+	return c.field, c.isStruct
+}
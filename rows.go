@@ -0,0 +1,195 @@
+package land
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/dchest/uniuri"
+)
+
+type Rows interface {
+	Next() bool
+	Scan(dest any) error
+	Err() error
+	Close() error
+}
+
+type resultRows struct {
+	manager *queryManager
+	rows    *sql.Rows
+	mapping *rowMapping
+	cursor  *serverCursor
+	err     error
+	closed  bool
+}
+
+type serverCursor struct {
+	context   context.Context
+	tx        *sql.Tx
+	name      string
+	fetchSize int
+	fetched   int
+	owned     bool
+}
+
+func createResultRows(m *queryManager, rows *sql.Rows, cursor *serverCursor) (*resultRows, error) {
+	columnsTypes, err := rows.ColumnTypes()
+	if err != nil {
+		_ = rows.Close()
+		return nil, err
+	}
+	return &resultRows{
+		manager: m,
+		rows:    rows,
+		mapping: m.createRowMapping(columnsTypes),
+		cursor:  cursor,
+	}, nil
+}
+
+func (r *resultRows) Next() bool {
+	if r.err != nil || r.closed {
+		return false
+	}
+	if r.rows.Next() {
+		if r.cursor != nil {
+			r.cursor.fetched++
+		}
+		return true
+	}
+	if err := r.rows.Err(); err != nil {
+		r.err = r.manager.createQueryError(err)
+		return false
+	}
+	if r.cursor == nil || r.cursor.fetched < r.cursor.fetchSize {
+		return false
+	}
+	if err := r.rows.Close(); err != nil {
+		r.err = r.manager.createQueryError(err)
+		return false
+	}
+	rows, err := r.cursor.fetch()
+	if err != nil {
+		r.err = r.manager.createQueryError(err)
+		return false
+	}
+	r.rows = rows
+	return r.Next()
+}
+
+func (r *resultRows) Scan(dest any) error {
+	m := r.manager.setDest(dest)
+	if !m.destRef.v.IsValid() || !m.destRef.v.CanSet() {
+		return ErrInvalidDest
+	}
+	rowData, err := m.getRowData(r.rows, r.mapping)
+	if err != nil {
+		return m.createQueryError(err)
+	}
+	m.setRowDataToResult(rowData)
+	return m.runAfterFind(dest)
+}
+
+func (r *resultRows) Err() error {
+	return r.err
+}
+
+func (r *resultRows) Close() error {
+	if r.closed {
+		return nil
+	}
+	r.closed = true
+	err := r.rows.Close()
+	if r.cursor != nil {
+		err = errors.Join(err, r.cursor.close(err != nil || r.err != nil))
+	}
+	return err
+}
+
+func createServerCursor(ctx context.Context, l *land, fetchSize int) (*serverCursor, error) {
+	cursor := &serverCursor{
+		context:   ctx,
+		name:      "land_cursor_" + strings.ToLower(uniuri.New()),
+		fetchSize: fetchSize,
+	}
+	if l.transaction != nil {
+		if err := l.transaction.check(); err != nil {
+			return nil, err
+		}
+		cursor.tx = l.transaction.tx
+		return cursor, nil
+	}
+	if err := l.checkConnection(); err != nil {
+		return nil, err
+	}
+	tx, err := l.db.connection.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	cursor.tx = tx
+	cursor.owned = true
+	return cursor, nil
+}
+
+func (c *serverCursor) declare(query string, args []any) error {
+	_, err := c.tx.ExecContext(c.context, c.createDeclareQuery(query), args...)
+	if err != nil && c.owned {
+		_ = c.tx.Rollback()
+	}
+	return err
+}
+
+func (c *serverCursor) fetch() (*sql.Rows, error) {
+	c.fetched = 0
+	return c.tx.QueryContext(c.context, c.createFetchQuery())
+}
+
+func (c *serverCursor) close(failed bool) error {
+	_, err := c.tx.ExecContext(c.context, c.createCloseQuery())
+	if !c.owned {
+		return err
+	}
+	if err != nil || failed {
+		return errors.Join(err, c.tx.Rollback())
+	}
+	return c.tx.Commit()
+}
+
+func (c *serverCursor) createDeclareQuery(query string) string {
+	return fmt.Sprintf("DECLARE %s NO SCROLL CURSOR FOR %s;", c.name, strings.TrimSuffix(query, ";"))
+}
+
+func (c *serverCursor) createFetchQuery() string {
+	return fmt.Sprintf("FETCH FORWARD %d FROM %s;", c.fetchSize, c.name)
+}
+
+func (c *serverCursor) createCloseQuery() string {
+	return fmt.Sprintf("CLOSE %s;", c.name)
+}
+
+func Iterate[T any](ctx context.Context, q SelectQuery, fn func(row T) error) (err error) {
+	if err := validateResultType[T](q.getPtr().entity); err != nil {
+		return err
+	}
+	rows, err := q.getPtr().allUnlessLimited().Rows(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := rows.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+	for rows.Next() {
+		var row T
+		if err := rows.Scan(&row); err != nil {
+			return err
+		}
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
@@ -0,0 +1,43 @@
+package land
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServerCursorQueries(t *testing.T) {
+	test := assert.New(t)
+	q := testEntity(testCreatePostgresInstance()).Select()
+	q.Where().Column(testActive).Equal(true)
+	q.All().Cursor(500)
+	query, args := q.GetSQLWithArgs()
+	c := &serverCursor{name: "land_cursor_test", fetchSize: q.getPtr().fetchSize}
+	test.Equal(
+		`DECLARE land_cursor_test NO SCROLL CURSOR FOR SELECT * FROM "tests" AS "t" WHERE "t"."active" = $1;`,
+		c.createDeclareQuery(query),
+	)
+	test.Equal([]any{true}, args)
+	test.Equal(`FETCH FORWARD 500 FROM land_cursor_test;`, c.createFetchQuery())
+	test.Equal(`CLOSE land_cursor_test;`, c.createCloseQuery())
+}
+
+func TestIterateResultTypeMismatch(t *testing.T) {
+	test := assert.New(t)
+	q := testEntity(testCreatePostgresInstance()).Select()
+	err := Iterate(
+		context.Background(), q, func(row testUnrelatedModel) error {
+			return nil
+		},
+	)
+	test.ErrorIs(err, ErrResultTypeMismatch)
+}
+
+func TestScanMapDest(t *testing.T) {
+	test := assert.New(t)
+	var result map[string]any
+	m := createQueryManager(nil, nil).setDest(&result)
+	m.setValue(m.createRowDataModel(), testName, "Dominik")
+	test.Equal(map[string]any{"Name": "Dominik"}, result)
+}
@@ -2,6 +2,7 @@ package land
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"strings"
 )
@@ -22,11 +23,20 @@ type SelectQuery interface {
 	Single() SelectQuery
 	Limit(limit int) SelectQuery
 	Exists() bool
+	ExistsE(ctx context.Context) (bool, error)
 	All() SelectQuery
+	Cursor(fetchSize int) SelectQuery
+	WithDeleted() SelectQuery
+	OnlyDeleted() SelectQuery
+	Preload(relations ...string) SelectQuery
 	Param(param Param) SelectQuery
 	GetSQL() string
+	GetSQLWithArgs() (string, []any)
 	GetResult(value any)
+	ScanE(ctx context.Context, dest any) error
+	Rows(ctx context.Context) (Rows, error)
 	Exec()
+	ExecE(ctx context.Context) (sql.Result, error)
 	
 	getPtr() *selectQueryBuilder
 }
@@ -45,6 +55,10 @@ type selectQueryBuilder struct {
 	withs         []*withQueryBuilder
 	param         Param
 	distinct      bool
+	fetchSize     int
+	deleted       string
+	preloads      []string
+	limited       bool
 }
 
 func createSelectQuery(entity *entity) *selectQueryBuilder {
@@ -101,27 +115,75 @@ func (q *selectQueryBuilder) Columns(columns ...string) ColumnsQuery {
 }
 
 func (q *selectQueryBuilder) Exec() {
-	createQueryManager(q.entity, q.context).setQuery(q.GetSQL()).setQueryType(Select).exec()
+	query, args := q.GetSQLWithArgs()
+	createQueryManager(q.entity, q.context).setQuery(query).setArgs(args).setQueryType(Select).exec()
 }
 
 func (q *selectQueryBuilder) GetResult(dest any) {
+	query, args := q.GetSQLWithArgs()
 	createQueryManager(
 		q.entity, q.context,
-	).setQuery(q.GetSQL()).setQueryType(Select).setDest(dest).getResult()
+	).setQuery(query).setArgs(args).setQueryType(Select).setAfterFind(q.createAfterFind(q.context, dest)).setDest(dest).getResult()
+}
+
+func (q *selectQueryBuilder) ExecE(ctx context.Context) (sql.Result, error) {
+	query, args := q.GetSQLWithArgs()
+	return createQueryManager(q.entity, ctx).setQuery(query).setArgs(args).setQueryType(Select).execE()
+}
+
+func (q *selectQueryBuilder) ScanE(ctx context.Context, dest any) error {
+	query, args := q.GetSQLWithArgs()
+	return createQueryManager(q.entity, ctx).setQuery(query).setArgs(args).setQueryType(Select).setAfterFind(q.createAfterFind(ctx, dest)).setDest(dest).getResultE()
+}
+
+func (q *selectQueryBuilder) Rows(ctx context.Context) (Rows, error) {
+	if len(q.preloads) > 0 {
+		return nil, ErrPreloadRows
+	}
+	query, args := q.GetSQLWithArgs()
+	return createQueryManager(q.entity, ctx).setQuery(query).setArgs(args).setQueryType(Select).setAfterFind(q.createAfterFind(ctx, nil)).getRows(q.fetchSize)
+}
+
+func (q *selectQueryBuilder) createAfterFind(ctx context.Context, dest any) func(dest any) error {
+	if dest != nil && len(q.preloads) == 0 && !q.entity.hasFindHooks(dest) {
+		return nil
+	}
+	return func(dest any) error {
+		if err := preloadRelations(ctx, q.entity, dest, q.preloads); err != nil {
+			return err
+		}
+		return q.entity.runAfterFind(ctx, q, dest)
+	}
 }
 
 func (q *selectQueryBuilder) Exists() bool {
 	var result bool
-	createQueryManager(q.entity, q.context).
-		setQuery(fmt.Sprintf("SELECT EXISTS(%s);", strings.TrimSuffix(q.GetSQL(), q.getQueryDivider()))).
-		setQueryType(Select).
-		setDest(&result).
-		getResult()
+	q.createExistsQueryManager(q.context).setDest(&result).getResult()
 	return result
 }
 
+func (q *selectQueryBuilder) ExistsE(ctx context.Context) (bool, error) {
+	var result bool
+	err := q.createExistsQueryManager(ctx).setDest(&result).getResultE()
+	return result, err
+}
+
+func (q *selectQueryBuilder) createExistsQueryManager(ctx context.Context) *queryManager {
+	query, args := q.GetSQLWithArgs()
+	return createQueryManager(q.entity, ctx).
+		setQuery(fmt.Sprintf("SELECT EXISTS(%s);", strings.TrimSuffix(query, q.getQueryDivider()))).
+		setArgs(args).
+		setQueryType(Select)
+}
+
 func (q *selectQueryBuilder) GetSQL() string {
-	return q.createQueryString() + q.getQueryDivider()
+	query, _ := q.GetSQLWithArgs()
+	return query
+}
+
+func (q *selectQueryBuilder) GetSQLWithArgs() (string, []any) {
+	q.resetArgs()
+	return q.createQueryString() + q.getQueryDivider(), q.getArgs()
 }
 
 func (q *selectQueryBuilder) Join(entity ...Entity) JoinQuery {
@@ -178,11 +240,13 @@ func (q *selectQueryBuilder) Offset(offset int) SelectQuery {
 
 func (q *selectQueryBuilder) Single() SelectQuery {
 	q.param.Limit = 1
+	q.limited = true
 	return q
 }
 
 func (q *selectQueryBuilder) Limit(limit int) SelectQuery {
 	q.param.Limit = limit
+	q.limited = true
 	return q
 }
 
@@ -191,6 +255,7 @@ func (q *selectQueryBuilder) Param(param Param) SelectQuery {
 		param.Order[i].Dynamic = true
 	}
 	q.param = param
+	q.limited = true
 	if len(param.Order) > 0 {
 		order := createOrderQuery(q.entity, q.columns, q.singleColumns, param.Order...)
 		q.orders = append(q.orders, order)
@@ -203,6 +268,33 @@ func (q *selectQueryBuilder) All() SelectQuery {
 	return q
 }
 
+func (q *selectQueryBuilder) allUnlessLimited() SelectQuery {
+	if !q.limited {
+		q.param.All = true
+	}
+	return q
+}
+
+func (q *selectQueryBuilder) Cursor(fetchSize int) SelectQuery {
+	q.fetchSize = fetchSize
+	return q
+}
+
+func (q *selectQueryBuilder) Preload(relations ...string) SelectQuery {
+	q.preloads = append(q.preloads, relations...)
+	return q
+}
+
+func (q *selectQueryBuilder) WithDeleted() SelectQuery {
+	q.deleted = deletedInclude
+	return q
+}
+
+func (q *selectQueryBuilder) OnlyDeleted() SelectQuery {
+	q.deleted = deletedOnly
+	return q
+}
+
 func (q *selectQueryBuilder) createQueryString() string {
 	result := make([]string, 0)
 	if len(q.withs) > 0 {
@@ -241,6 +333,7 @@ func (q *selectQueryBuilder) createColumnsPart() []string {
 		if !column.use {
 			continue
 		}
+		q.shareArgs(column.queryBuilder)
 		result = append(result, column.getQueryString())
 	}
 	return result
@@ -259,6 +352,7 @@ func (q *selectQueryBuilder) createFromPart() []string {
 func (q *selectQueryBuilder) createWithsPart() string {
 	result := make([]string, 0)
 	for _, with := range q.withs {
+		q.shareArgs(with.queryBuilder)
 		result = append(result, with.createQueryString())
 	}
 	if len(result) > 0 {
@@ -270,6 +364,7 @@ func (q *selectQueryBuilder) createWithsPart() string {
 func (q *selectQueryBuilder) createJoinsPart() []string {
 	result := make([]string, 0)
 	for _, join := range q.joins {
+		join.withDeleted = q.deleted == deletedInclude
 		result = append(result, strings.Join(join.createQueryString(), " "))
 	}
 	return result
@@ -286,7 +381,11 @@ func (q *selectQueryBuilder) createWheresPart() []string {
 	result := make([]string, 0)
 	i := 0
 	q.createFulltextConditions()
-	for _, where := range q.wheres {
+	wheres := q.wheres
+	if deleted := createDeletedCondition(q.entity, q.deleted); deleted != nil {
+		wheres = append(wheres[:len(wheres):len(wheres)], deleted)
+	}
+	for _, where := range wheres {
 		if where.excludeFromZeroLevel || !where.use {
 			continue
 		}
@@ -297,6 +396,7 @@ func (q *selectQueryBuilder) createWheresPart() []string {
 		if i > 0 {
 			condition = append(condition, "AND")
 		}
+		q.shareArgs(where.queryBuilder)
 		condition = append(condition, where.createQueryString())
 		result = append(result, strings.Join(condition, " "))
 		i++
@@ -317,6 +417,7 @@ func (q *selectQueryBuilder) createHavingsPart() []string {
 		if i > 0 {
 			condition = append(condition, "AND")
 		}
+		q.shareArgs(having.queryBuilder)
 		condition = append(condition, having.createQueryString())
 		result = append(result, strings.Join(condition, " "))
 	}
@@ -1,6 +1,8 @@
 package land
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -41,7 +43,35 @@ func TestSelectWhere(t *testing.T) {
 	q.Where().Column("name").Equal("dominik").
 		Or(q.Where().Column("name").Equal("linduska"))
 	q.All()
-	test.Equal(`SELECT "t"."name" FROM "tests" AS "t" WHERE "t"."name" = 'daar' AND ("t"."name" = 'dominik' OR "t"."name" = 'linduska');`, q.GetSQL())
+	query, args := q.GetSQLWithArgs()
+	test.Equal(`SELECT "t"."name" FROM "tests" AS "t" WHERE "t"."name" = $1 AND ("t"."name" = $2 OR "t"."name" = $3);`, query)
+	test.Equal([]any{"daar", "dominik", "linduska"}, args)
+}
+
+func TestSelectWhereContains(t *testing.T) {
+	test := assert.New(t)
+	q := testEntity(testCreatePostgresInstance()).Select()
+	q.Where().Column(Id).Contains([]int{1, 2})
+	q.All()
+	query, args := q.GetSQLWithArgs()
+	test.Equal(`SELECT * FROM "tests" AS "t" WHERE "t"."id" IN ($1,$2);`, query)
+	test.Equal([]any{int64(1), int64(2)}, args)
+}
+
+func TestSelectWhereSubquery(t *testing.T) {
+	test := assert.New(t)
+	e := testEntity(testCreatePostgresInstance())
+	sub := e.Select()
+	sub.Column(Id).Count()
+	sub.Where().Column(testActive).Equal(true)
+	sub.All()
+	q := e.Select()
+	q.Where().Column(testName).Equal("daar")
+	q.Where().Subquery(sub).Equal(2)
+	q.All()
+	query, args := q.GetSQLWithArgs()
+	test.Equal(`SELECT * FROM "tests" AS "t" WHERE "t"."name" = $1 AND (SELECT COUNT("t"."id") FROM "tests" AS "t" WHERE "t"."active" = $2) = $3;`, query)
+	test.Equal([]any{"daar", true, int64(2)}, args)
 }
 
 func TestSelectGroup(t *testing.T) {
@@ -66,9 +96,11 @@ func TestSelectFulltext(t *testing.T) {
 	test := assert.New(t)
 	q := testEntity(testCreatePostgresInstance()).Select()
 	q.Column("test")
-	q.Fulltext("test")
+	q.Fulltext("test O'Brien")
 	q.All()
-	test.Equal(`SELECT "t"."test" FROM "tests" AS "t" WHERE "t"."vectors" @@ to_tsquery('test:*');`, q.GetSQL())
+	query, args := q.GetSQLWithArgs()
+	test.Equal(`SELECT "t"."test" FROM "tests" AS "t" WHERE "t"."vectors" @@ to_tsquery($1);`, query)
+	test.Equal([]any{"test:* & o’brien:*"}, args)
 }
 
 func TestSelectCountGroup(t *testing.T) {
@@ -80,3 +112,17 @@ func TestSelectCountGroup(t *testing.T) {
 	q.All()
 	test.Equal(`SELECT "t"."lastname",COUNT("t"."lastname") AS "lastname_count" FROM "tests" AS "t" GROUP BY "t"."lastname";`, q.GetSQL())
 }
+
+func TestSelectScanEInvalidDest(t *testing.T) {
+	test := assert.New(t)
+	q := testEntity(testCreatePostgresInstance()).Select()
+	q.Where().Column(testName).Equal("daar")
+	var result []testModel
+	err := q.ScanE(context.Background(), result)
+	test.ErrorIs(err, ErrInvalidDest)
+	var queryErr *QueryError
+	test.True(errors.As(err, &queryErr))
+	test.Equal(Select, queryErr.QueryType)
+	test.Equal(`SELECT * FROM "tests" AS "t" WHERE "t"."name" = $1 LIMIT 20;`, queryErr.SQL)
+	test.Equal([]any{"daar"}, queryErr.Args)
+}
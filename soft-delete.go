@@ -0,0 +1,20 @@
+package land
+
+const (
+	deletedExclude = ""
+	deletedInclude = "include"
+	deletedOnly    = "only"
+)
+
+func createDeletedCondition(entity *entity, scope string) *conditionQueryBuilder {
+	if scope == deletedInclude || !entity.hasColumn(DeletedAt) {
+		return nil
+	}
+	where := createConditionQuery(entity)
+	where.Column(DeletedAt)
+	if scope == deletedOnly {
+		where.Not()
+	}
+	where.Null()
+	return where
+}
@@ -0,0 +1,86 @@
+package land
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testSoftDeleteEntity(l Land) Entity {
+	return testEntity(l).SetSoftDelete()
+}
+
+func TestSoftDeleteSelect(t *testing.T) {
+	test := assert.New(t)
+	q := testSoftDeleteEntity(testCreatePostgresInstance()).Select()
+	q.Where().Column(testName).Equal("Dominik")
+	test.Equal(
+		`SELECT * FROM "tests" AS "t" WHERE "t"."name" = $1 AND "t"."deleted_at" IS NULL LIMIT 20;`,
+		q.GetSQL(),
+	)
+	test.Equal(
+		`SELECT * FROM "tests" AS "t" WHERE "t"."deleted_at" IS NOT NULL LIMIT 20;`,
+		testSoftDeleteEntity(testCreatePostgresInstance()).Select().OnlyDeleted().GetSQL(),
+	)
+	test.Equal(
+		`SELECT * FROM "tests" AS "t" LIMIT 20;`,
+		testSoftDeleteEntity(testCreatePostgresInstance()).Select().WithDeleted().GetSQL(),
+	)
+}
+
+func TestSoftDeleteJoin(t *testing.T) {
+	test := assert.New(t)
+	l := testCreatePostgresInstance()
+	second := testSecondEntity(l).SetSoftDelete()
+	q := testEntity(l).Select()
+	q.Join().On(second, "user_id")
+	test.Equal(
+		`SELECT * FROM "tests" AS "t" LEFT JOIN tests AS t2 ON "t"."id" = "t2"."user_id" AND "t2"."deleted_at" IS NULL LIMIT 20;`,
+		q.GetSQL(),
+	)
+}
+
+func TestSoftDeleteJoinOnlyDeleted(t *testing.T) {
+	test := assert.New(t)
+	l := testCreatePostgresInstance()
+	second := testSecondEntity(l).SetSoftDelete()
+	q := testSoftDeleteEntity(l).Select().OnlyDeleted()
+	q.Join().On(second, "user_id")
+	test.Equal(
+		`SELECT * FROM "tests" AS "t" LEFT JOIN tests AS t2 ON "t"."id" = "t2"."user_id" AND "t2"."deleted_at" IS NULL WHERE "t"."deleted_at" IS NOT NULL LIMIT 20;`,
+		q.GetSQL(),
+	)
+	q = testSoftDeleteEntity(l).Select().WithDeleted()
+	q.Join().On(second, "user_id")
+	test.Equal(
+		`SELECT * FROM "tests" AS "t" LEFT JOIN tests AS t2 ON "t"."id" = "t2"."user_id" LIMIT 20;`,
+		q.GetSQL(),
+	)
+}
+
+func TestSoftDeleteDelete(t *testing.T) {
+	test := assert.New(t)
+	q := testSoftDeleteEntity(testCreatePostgresInstance()).Delete()
+	q.Where().Column(Id).Equal(1)
+	test.Equal(
+		`UPDATE "tests" AS "t" SET "deleted_at" = CURRENT_TIMESTAMP WHERE "t"."id" = $1 AND "t"."deleted_at" IS NULL;`,
+		q.GetSQL(),
+	)
+	q.ForceDelete()
+	test.Equal(`DELETE FROM "tests" AS "t" WHERE "t"."id" = $1;`, q.GetSQL())
+}
+
+func TestSoftDeleteUpdate(t *testing.T) {
+	test := assert.New(t)
+	q := testSoftDeleteEntity(testCreatePostgresInstance()).Update().SetValues(map[string]any{"name": "Dominik"})
+	test.Equal(
+		`UPDATE "tests" AS "t" SET "name" = $1,"updated_at" = CURRENT_TIMESTAMP WHERE "t"."deleted_at" IS NULL;`,
+		q.GetSQL(),
+	)
+	q = testSoftDeleteEntity(testCreatePostgresInstance()).Update().Restore()
+	q.Where().Column(Id).Equal(1)
+	test.Equal(
+		`UPDATE "tests" AS "t" SET "deleted_at" = NULL WHERE "t"."id" = $1 AND "t"."deleted_at" IS NOT NULL;`,
+		q.GetSQL(),
+	)
+}
@@ -1,7 +1,143 @@
 package land
 
+import (
+	"context"
+	"crypto/tls"
+	"database/sql/driver"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const (
+	SSLDisable    = "disable"
+	SSLAllow      = "allow"
+	SSLPrefer     = "prefer"
+	SSLRequire    = "require"
+	SSLVerifyCA   = "verify-ca"
+	SSLVerifyFull = "verify-full"
+)
+
 const (
-	SSLDisable  = "disable"
-	SSLRequire  = "require"
-	SSLVerifyCA = "verify-ca"
+	sslRequestCode int32 = 80877103
+)
+
+var (
+	ErrSSLNotSupported = errors.New("land: SSL is not enabled on the server")
 )
+
+type tlsDialer struct {
+	config *tls.Config
+	dialer net.Dialer
+}
+
+type sslFallbackConnector struct {
+	primary  driver.Connector
+	fallback driver.Connector
+	mode     string
+}
+
+func createTLSDialer(config *tls.Config) *tlsDialer {
+	return &tlsDialer{config: config}
+}
+
+func (d *tlsDialer) Dial(network, address string) (net.Conn, error) {
+	return d.DialContext(context.Background(), network, address)
+}
+
+func (d *tlsDialer) DialTimeout(network, address string, timeout time.Duration) (net.Conn, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return d.DialContext(ctx, network, address)
+}
+
+func (d *tlsDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	conn, err := d.dialer.DialContext(ctx, network, address)
+	if err != nil {
+		return nil, err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+		defer conn.SetDeadline(time.Time{})
+	}
+	if err := d.requestSSL(conn); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	tlsConn := tls.Client(conn, d.createConfig(address))
+	if err := tlsConn.HandshakeContext(ctx); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return tlsConn, nil
+}
+
+func (d *tlsDialer) requestSSL(conn net.Conn) error {
+	request := make([]byte, 8)
+	binary.BigEndian.PutUint32(request[0:4], 8)
+	binary.BigEndian.PutUint32(request[4:8], uint32(sslRequestCode))
+	if _, err := conn.Write(request); err != nil {
+		return err
+	}
+	response := make([]byte, 1)
+	if _, err := io.ReadFull(conn, response); err != nil {
+		return err
+	}
+	if response[0] != 'S' {
+		return ErrSSLNotSupported
+	}
+	return nil
+}
+
+func (d *tlsDialer) createConfig(address string) *tls.Config {
+	config := d.config.Clone()
+	if len(config.ServerName) > 0 || config.InsecureSkipVerify {
+		return config
+	}
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		host = address
+	}
+	config.ServerName = host
+	return config
+}
+
+func createSSLFallbackConnector(c *connector) (*sslFallbackConnector, error) {
+	primaryMode, fallbackMode := SSLRequire, SSLDisable
+	if c.sslmode == SSLAllow {
+		primaryMode, fallbackMode = SSLDisable, SSLRequire
+	}
+	primary, err := pq.NewConnector(c.createConnectionStringWithSSL(primaryMode))
+	if err != nil {
+		return nil, err
+	}
+	fallback, err := pq.NewConnector(c.createConnectionStringWithSSL(fallbackMode))
+	if err != nil {
+		return nil, err
+	}
+	return &sslFallbackConnector{primary: primary, fallback: fallback, mode: c.sslmode}, nil
+}
+
+func (c *sslFallbackConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.primary.Connect(ctx)
+	if err == nil {
+		return conn, nil
+	}
+	if c.mode == SSLPrefer && !errors.Is(err, pq.ErrSSLNotSupported) {
+		return nil, err
+	}
+	conn, fallbackErr := c.fallback.Connect(ctx)
+	if fallbackErr != nil {
+		return nil, fmt.Errorf("%w (sslmode %s fallback: %v)", err, c.mode, fallbackErr)
+	}
+	return conn, nil
+}
+
+func (c *sslFallbackConnector) Driver() driver.Driver {
+	return c.primary.Driver()
+}
@@ -0,0 +1,60 @@
+package land
+
+import (
+	"crypto/tls"
+	"io"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSSLCertPaths(t *testing.T) {
+	test := assert.New(t)
+	c := Connect().Postgres().
+		SSL(SSLVerifyFull).
+		SSLRootCert("/etc/ssl/root.crt").
+		SSLCert("certs/client.crt").
+		SSLKey("/etc/ssl/../ssl/client.key").
+		getPtr()
+	clientCert, _ := filepath.Abs("certs/client.crt")
+	test.Equal("/etc/ssl/root.crt", c.certpath)
+	test.Equal(clientCert, c.sslcert)
+	test.Equal("/etc/ssl/client.key", c.sslkey)
+	test.Equal(
+		"sslrootcert=/etc/ssl/root.crt sslcert="+clientCert+" sslkey=/etc/ssl/client.key sslmode=verify-full",
+		c.createConnectionString(),
+	)
+}
+
+func TestSSLFallbackModes(t *testing.T) {
+	test := assert.New(t)
+	prefer, err := createSSLFallbackConnector(Connect().Postgres().SSL(SSLPrefer).getPtr())
+	test.NoError(err)
+	test.Equal(SSLPrefer, prefer.mode)
+	allow, err := createSSLFallbackConnector(Connect().Postgres().SSL(SSLAllow).getPtr())
+	test.NoError(err)
+	test.Equal(SSLAllow, allow.mode)
+}
+
+func TestTLSDialerSSLNotSupported(t *testing.T) {
+	test := assert.New(t)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	test.NoError(err)
+	defer listener.Close()
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		request := make([]byte, 8)
+		if _, err := io.ReadFull(conn, request); err != nil {
+			return
+		}
+		_, _ = conn.Write([]byte{'N'})
+	}()
+	_, err = createTLSDialer(&tls.Config{}).Dial("tcp", listener.Addr().String())
+	test.ErrorIs(err, ErrSSLNotSupported)
+}
@@ -0,0 +1,169 @@
+package land
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/iancoleman/strcase"
+)
+
+type structField struct {
+	name      string
+	fieldName string
+	index     []int
+	omitempty bool
+	readonly  bool
+	tagged    bool
+	assign    valueAssigner
+}
+
+type structFields struct {
+	fields      []*structField
+	byName      map[string]*structField
+	byFieldName map[string]*structField
+	lookups     sync.Map
+}
+
+const (
+	tagName          = "land"
+	tagIgnore        = "-"
+	tagOptOmitempty  = "omitempty"
+	tagOptReadonly   = "readonly"
+	tagOptsSeparator = ","
+)
+
+var (
+	structFieldsCache sync.Map
+	timeType          = reflect.TypeOf(time.Time{})
+)
+
+func getStructFields(t reflect.Type) *structFields {
+	if cached, ok := structFieldsCache.Load(t); ok {
+		return cached.(*structFields)
+	}
+	fields := &structFields{
+		fields:      make([]*structField, 0),
+		byName:      make(map[string]*structField),
+		byFieldName: make(map[string]*structField),
+	}
+	fields.collect(t, nil)
+	cached, _ := structFieldsCache.LoadOrStore(t, fields)
+	return cached.(*structFields)
+}
+
+func (s *structFields) get(column string) *structField {
+	if f, ok := s.byName[column]; ok {
+		return f
+	}
+	if cached, ok := s.lookups.Load(column); ok {
+		return cached.(*structField)
+	}
+	f := s.byFieldName[strcase.ToCamel(column)]
+	s.lookups.Store(column, f)
+	return f
+}
+
+func (s *structFields) collect(t reflect.Type, parentIndex []int) {
+	embedded := make([]reflect.StructField, 0)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, hasTag := f.Tag.Lookup(tagName)
+		if tag == tagIgnore {
+			continue
+		}
+		name, opts := parseTag(tag)
+		if f.Anonymous && len(name) == 0 && isStructType(f.Type) {
+			embedded = append(embedded, f)
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		tagged := hasTag && len(name) > 0
+		if !tagged {
+			name = strcase.ToSnake(f.Name)
+		}
+		s.add(
+			&structField{
+				name:      name,
+				fieldName: f.Name,
+				index:     createFieldIndex(parentIndex, f.Index),
+				omitempty: opts[tagOptOmitempty],
+				readonly:  opts[tagOptReadonly],
+				tagged:    tagged,
+				assign:    createValueAssigner(f.Type),
+			},
+		)
+	}
+	for _, f := range embedded {
+		ft := f.Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		s.collect(ft, createFieldIndex(parentIndex, f.Index))
+	}
+}
+
+func (s *structFields) add(f *structField) {
+	if _, ok := s.byName[f.name]; ok {
+		return
+	}
+	s.fields = append(s.fields, f)
+	s.byName[f.name] = f
+	if _, ok := s.byFieldName[f.fieldName]; !ok && !f.tagged {
+		s.byFieldName[f.fieldName] = f
+	}
+}
+
+func (f *structField) read(v reflect.Value) reflect.Value {
+	for _, i := range f.index {
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}
+			}
+			v = v.Elem()
+		}
+		v = v.Field(i)
+	}
+	return v
+}
+
+func (f *structField) write(v reflect.Value) reflect.Value {
+	for _, i := range f.index {
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() && !v.CanSet() {
+				return reflect.Value{}
+			}
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(i)
+	}
+	return v
+}
+
+func parseTag(tag string) (string, map[string]bool) {
+	parts := strings.Split(tag, tagOptsSeparator)
+	opts := make(map[string]bool)
+	for _, opt := range parts[1:] {
+		opts[strings.TrimSpace(opt)] = true
+	}
+	return strings.TrimSpace(parts[0]), opts
+}
+
+func isStructType(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct && t != timeType && !reflect.PointerTo(t).Implements(scannerType)
+}
+
+func createFieldIndex(parentIndex []int, index []int) []int {
+	result := make([]int, 0, len(parentIndex)+len(index))
+	result = append(result, parentIndex...)
+	return append(result, index...)
+}
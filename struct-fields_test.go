@@ -0,0 +1,117 @@
+package land
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testTimestamps struct {
+	CreatedAt string `land:",readonly"`
+}
+
+type testTaggedModel struct {
+	testTimestamps
+	UserID   int    `land:"user_id"`
+	FullName string `land:"name"`
+	Lastname string `land:",omitempty"`
+	Secret   string `land:"-"`
+	Active   bool
+}
+
+func TestStructFields(t *testing.T) {
+	test := assert.New(t)
+	fields := getStructFields(reflect.TypeOf(testTaggedModel{}))
+	test.Equal("UserID", fields.get("user_id").fieldName)
+	test.Equal("FullName", fields.get("name").fieldName)
+	test.True(fields.get("lastname").omitempty)
+	test.True(fields.get("created_at").readonly)
+	test.Equal([]int{0, 0}, fields.get("created_at").index)
+	test.Equal("Active", fields.get("active").fieldName)
+	test.Nil(fields.get("secret"))
+	test.Nil(fields.get("full_name"))
+	test.Nil(fields.get("user_i_d"))
+	test.Same(fields, getStructFields(reflect.TypeOf(testTaggedModel{})))
+}
+
+func TestStructFieldsWriteEmbedded(t *testing.T) {
+	test := assert.New(t)
+	var result testTaggedModel
+	m := createQueryManager(nil, nil).setDest(&result)
+	m.setValueToStruct(m.destRef.v, "created_at", "2023-01-01")
+	m.setValueToStruct(m.destRef.v, "user_id", 7)
+	test.Equal("2023-01-01", result.CreatedAt)
+	test.Equal(7, result.UserID)
+	var embeddedPtr struct{ *testTimestamps }
+	m = createQueryManager(nil, nil).setDest(&embeddedPtr)
+	m.setValueToStruct(m.destRef.v, "created_at", "2023-01-01")
+	test.Nil(embeddedPtr.testTimestamps)
+}
+
+func TestInsertTaggedModel(t *testing.T) {
+	test := assert.New(t)
+	q := testEntity(testCreatePostgresInstance()).
+		Insert().
+		SetValues(testTaggedModel{FullName: "Dominik", Active: true})
+	query, args := q.GetSQLWithArgs()
+	test.Equal(
+		`INSERT INTO "tests" ("name","active","vectors","created_at","updated_at") VALUES ($1,$2,to_tsvector(''),CURRENT_TIMESTAMP,CURRENT_TIMESTAMP);`,
+		query,
+	)
+	test.Equal([]any{"Dominik", true}, args)
+}
+
+func TestUpdateTaggedModel(t *testing.T) {
+	test := assert.New(t)
+	q := testEntity(testCreatePostgresInstance()).
+		Update().
+		SetValues(&testTaggedModel{FullName: "Dominik", Lastname: "Linduska"})
+	query, args := q.GetSQLWithArgs()
+	test.Equal(
+		`UPDATE "tests" AS "t" SET "name" = $1,"lastname" = $2,"active" = $3,"updated_at" = CURRENT_TIMESTAMP;`,
+		query,
+	)
+	test.Equal([]any{"Dominik", "Linduska", false}, args)
+}
+
+type testScanModel struct {
+	Id        int
+	Name      string
+	Lastname  string
+	Active    bool
+	Score     float64
+	CreatedAt time.Time
+}
+
+func TestStructFieldAssign(t *testing.T) {
+	test := assert.New(t)
+	var result testScanModel
+	v := reflect.ValueOf(&result).Elem()
+	fields := getStructFields(v.Type())
+	date := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	test.NoError(fields.get("id").assign(fields.get("id").write(v), 7))
+	test.NoError(fields.get("score").assign(fields.get("score").write(v), 1))
+	test.NoError(fields.get("created_at").assign(fields.get("created_at").write(v), date))
+	test.NoError(fields.get("name").assign(fields.get("name").write(v), []byte("Dominik")))
+	test.Error(fields.get("active").assign(fields.get("active").write(v), "yes"))
+	test.Equal(testScanModel{Id: 7, Name: "Dominik", Score: 1, CreatedAt: date}, result)
+}
+
+func TestColumnMappingField(t *testing.T) {
+	test := assert.New(t)
+	c := createColumnMapping("nickname", "varchar")
+	field, isStruct := c.getField(reflect.TypeOf(testTaggedModel{}))
+	test.True(isStruct)
+	test.Nil(field)
+	field, isStruct = c.getField(reflect.TypeOf(testScanModel{}))
+	test.True(isStruct)
+	test.Nil(field)
+	c = createColumnMapping("name", "varchar")
+	field, _ = c.getField(reflect.TypeOf(testTaggedModel{}))
+	test.Equal("FullName", field.fieldName)
+	_, isStruct = c.getField(reflect.TypeOf(time.Time{}))
+	test.False(isStruct)
+	test.Equal("Name", c.mapKey.String())
+}
@@ -1,51 +1,101 @@
 package land
 
 import (
+	"context"
 	"database/sql"
+	"errors"
+	"fmt"
 )
 
 type Transaction interface {
-	ErrorManager
-	Begin()
-	Rollback()
-	Commit()
+	Land
 }
 
 type transactionManager struct {
-	*errorManager
-	land         *land
-	errorHandler *errorHandler
+	context context.Context
+	tx      *sql.Tx
+	done    bool
 }
 
-func createTransactionManager(land *land) *transactionManager {
+var (
+	ErrTxNotStarted     = errors.New("land: transaction not started, use Transaction()")
+	ErrTxAlreadyStarted = errors.New("land: transaction already started")
+	ErrTxDone           = errors.New("land: transaction already committed or rolled back")
+)
+
+func createTransactionManager(context context.Context) *transactionManager {
 	return &transactionManager{
-		errorManager: createErrorManager(),
-		land:         land,
-		errorHandler: createErrorHandler(land),
+		context: context,
 	}
 }
 
-func (m *transactionManager) Begin() {
-	// defer m.errorHandler.recover()
-	query := "BEGIN;"
-	_, err := m.connection().Exec(query)
-	m.check(err, query)
+func (m *transactionManager) begin(connection *sql.DB) error {
+	if m.tx != nil {
+		return ErrTxAlreadyStarted
+	}
+	tx, err := connection.BeginTx(m.context, nil)
+	if err != nil {
+		return classifyError(err)
+	}
+	m.tx = tx
+	m.done = false
+	return nil
 }
 
-func (m *transactionManager) Rollback() {
-	// defer m.errorHandler.recover()
-	query := "ROLLBACK;"
-	_, err := m.connection().Exec(query)
-	m.check(err, query)
+func (m *transactionManager) commit() error {
+	if m.tx == nil {
+		return ErrTxNotStarted
+	}
+	err := m.tx.Commit()
+	m.tx = nil
+	m.done = true
+	return classifyError(err)
 }
 
-func (m *transactionManager) Commit() {
-	// defer m.errorHandler.recover()
-	query := "COMMIT;"
-	_, err := m.connection().Exec(query)
-	m.check(err, query)
+func (m *transactionManager) rollback() error {
+	if m.tx == nil {
+		return ErrTxNotStarted
+	}
+	err := m.tx.Rollback()
+	m.tx = nil
+	m.done = true
+	return err
 }
 
-func (m *transactionManager) connection() *sql.DB {
-	return m.land.db.connection
+func (m *transactionManager) isActive() bool {
+	return m.tx != nil
+}
+
+func (m *transactionManager) check() error {
+	if m.done {
+		return ErrTxDone
+	}
+	if m.tx == nil {
+		return ErrTxNotStarted
+	}
+	return nil
+}
+
+func runInTransaction(tx *land, fn func(tx Land) error) (err error) {
+	if err = tx.Begin(); err != nil {
+		return err
+	}
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			_ = tx.Rollback()
+			panic(recovered)
+		}
+		if err != nil && !tx.transaction.isActive() {
+			return
+		}
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				err = fmt.Errorf("%w (rollback failed: %v)", err, rollbackErr)
+			}
+			return
+		}
+		err = tx.Commit()
+	}()
+	err = fn(tx)
+	return err
 }
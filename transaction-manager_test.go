@@ -0,0 +1,45 @@
+package land
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransactionNotStarted(t *testing.T) {
+	test := assert.New(t)
+	l := testCreatePostgresInstance()
+	test.ErrorIs(l.Begin(), ErrTxNotStarted)
+	test.ErrorIs(l.Commit(), ErrTxNotStarted)
+	tx := l.Transaction()
+	test.ErrorIs(tx.Commit(), ErrTxNotStarted)
+	test.ErrorIs(tx.Rollback(), ErrTxNotStarted)
+	test.Same(tx, tx.Transaction())
+}
+
+func TestTransactionDone(t *testing.T) {
+	test := assert.New(t)
+	l, fake := testFakeLand(t)
+	tx := l.Transaction()
+	_, err := testEntity(tx).Delete().ExecE(context.Background())
+	test.ErrorIs(err, ErrTxNotStarted)
+	test.NoError(tx.Begin())
+	test.NoError(tx.Commit())
+	_, err = testEntity(tx).Delete().ExecE(context.Background())
+	test.ErrorIs(err, ErrTxDone)
+	_, err = tx.Query("SELECT 1;")
+	test.ErrorIs(err, ErrTxDone)
+	test.Empty(fake.getQueries())
+}
+
+func TestTransactionCommitError(t *testing.T) {
+	test := assert.New(t)
+	l, fake := testFakeLand(t)
+	fake.setCommitError(&pq.Error{Code: sqlStateSerializationFailure})
+	err := l.InTransaction(context.Background(), func(tx Land) error {
+		return nil
+	})
+	test.ErrorIs(err, ErrSerializationFailure)
+}
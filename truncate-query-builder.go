@@ -2,12 +2,15 @@ package land
 
 import (
 	"context"
+	"database/sql"
 	"strings"
 )
 
 type TruncateQuery interface {
+	Context(context context.Context) TruncateQuery
 	GetSQL() string
 	Exec()
+	ExecE(ctx context.Context) (sql.Result, error)
 	RestartIdentity() TruncateQuery
 	Cascade() TruncateQuery
 }
@@ -28,6 +31,11 @@ func createTruncateQuery(entity *entity) *truncateQueryBuilder {
 	}
 }
 
+func (q *truncateQueryBuilder) Context(context context.Context) TruncateQuery {
+	q.context = context
+	return q
+}
+
 func (q *truncateQueryBuilder) GetSQL() string {
 	return q.createQueryString()
 }
@@ -36,6 +44,10 @@ func (q *truncateQueryBuilder) Exec() {
 	createQueryManager(q.entity, q.context).setQuery(q.GetSQL()).setQueryType(Truncate).exec()
 }
 
+func (q *truncateQueryBuilder) ExecE(ctx context.Context) (sql.Result, error) {
+	return createQueryManager(q.entity, ctx).setQuery(q.GetSQL()).setQueryType(Truncate).execE()
+}
+
 func (q *truncateQueryBuilder) RestartIdentity() TruncateQuery {
 	q.restartIdentity = true
 	return q
@@ -0,0 +1,35 @@
+package land
+
+import (
+	"database/sql/driver"
+	"strings"
+	"sync"
+)
+
+type Codec interface {
+	Decode(src any) (any, error)
+	Encode(value any) (driver.Value, error)
+}
+
+type codecValue struct {
+	codec Codec
+	value any
+}
+
+var typeCodecs sync.Map
+
+func RegisterType(dbType string, codec Codec) {
+	typeCodecs.Store(strings.ToLower(dbType), codec)
+}
+
+func getTypeCodec(dbType string) Codec {
+	codec, ok := typeCodecs.Load(strings.ToLower(dbType))
+	if !ok {
+		return nil
+	}
+	return codec.(Codec)
+}
+
+func (v codecValue) Value() (driver.Value, error) {
+	return v.codec.Encode(v.value)
+}
@@ -0,0 +1,102 @@
+package land
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testCents int64
+
+type testCentsCodec struct{}
+
+type testScannedID struct {
+	value int64
+}
+
+func (testCentsCodec) Decode(src any) (any, error) {
+	s, ok := src.([]byte)
+	if !ok {
+		return nil, fmt.Errorf("unexpected %T", src)
+	}
+	f, err := strconv.ParseFloat(string(s), 64)
+	if err != nil {
+		return nil, err
+	}
+	return testCents(f * 100), nil
+}
+
+func (testCentsCodec) Encode(value any) (driver.Value, error) {
+	cents, ok := value.(testCents)
+	if !ok {
+		return nil, fmt.Errorf("unexpected %T", value)
+	}
+	return fmt.Sprintf("%d.%02d", cents/100, cents%100), nil
+}
+
+func (s *testScannedID) Scan(src any) error {
+	v, ok := src.(int64)
+	if !ok {
+		return fmt.Errorf("unexpected %T", src)
+	}
+	s.value = v
+	return nil
+}
+
+func TestTypeCodecEncode(t *testing.T) {
+	test := assert.New(t)
+	RegisterType("test_money", testCentsCodec{})
+	q := testCreatePostgresInstance().
+		CreateEntity(testEntityName).
+		SetColumn("price", "test_money", ColOpts{}).
+		Insert().
+		SetValues(map[string]any{"price": testCents(1250)})
+	query, args := q.GetSQLWithArgs()
+	test.Equal(`INSERT INTO "tests" ("price") VALUES ($1);`, query)
+	test.Len(args, 1)
+	value, err := args[0].(driver.Valuer).Value()
+	test.NoError(err)
+	test.Equal("12.50", value)
+}
+
+func TestTypeCodecDecode(t *testing.T) {
+	test := assert.New(t)
+	RegisterType("TEST_MONEY", testCentsCodec{})
+	m := createQueryManager(nil, nil)
+	raw := any([]byte("12.50"))
+	value, err := m.getScannedValue("test_money", m.createScanTarget("test_money"))
+	test.NoError(err)
+	test.Nil(value)
+	value, err = m.getScannedValue("test_money", &raw)
+	test.NoError(err)
+	test.Equal(testCents(1250), value)
+	raw = []byte("not a number")
+	_, err = m.getScannedValue("test_money", &raw)
+	test.Error(err)
+}
+
+func TestScanUnknownType(t *testing.T) {
+	test := assert.New(t)
+	m := createQueryManager(nil, nil)
+	raw := any([]byte("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"))
+	value, err := m.getScannedValue("uuid", &raw)
+	test.NoError(err)
+	test.Equal("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11", value)
+	raw = []byte{0xde, 0xad}
+	value, err = m.getScannedValue(Bytea, &raw)
+	test.NoError(err)
+	test.Equal([]byte{0xde, 0xad}, value)
+}
+
+func TestScanScanner(t *testing.T) {
+	test := assert.New(t)
+	var result struct {
+		ID testScannedID
+	}
+	m := createQueryManager(nil, nil).setDest(&result)
+	m.setValueToStruct(m.destRef.v, "id", 7)
+	test.Equal(int64(7), result.ID.value)
+}
@@ -0,0 +1,85 @@
+package land
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+var (
+	ErrResultTypeMismatch = errors.New("land: result type has no field for entity columns")
+)
+
+var resultTypesCache sync.Map
+
+type resultTypeKey struct {
+	entity string
+	t      reflect.Type
+}
+
+func All[T any](ctx context.Context, q SelectQuery) ([]T, error) {
+	if err := validateResultType[T](q.getPtr().entity); err != nil {
+		return nil, err
+	}
+	result := make([]T, 0)
+	if err := q.getPtr().allUnlessLimited().ScanE(ctx, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func One[T any](ctx context.Context, q SelectQuery) (T, error) {
+	var result T
+	if err := validateResultType[T](q.getPtr().entity); err != nil {
+		return result, err
+	}
+	return scanFirst[T](ctx, q.Single())
+}
+
+func Scalar[T any](ctx context.Context, q SelectQuery) (T, error) {
+	return scanFirst[T](ctx, q.Single())
+}
+
+func scanFirst[T any](ctx context.Context, q SelectQuery) (T, error) {
+	var result T
+	items := make([]T, 0, 1)
+	if err := q.ScanE(ctx, &items); err != nil {
+		return result, err
+	}
+	if len(items) == 0 {
+		return result, classifyError(sql.ErrNoRows)
+	}
+	return items[0], nil
+}
+
+func validateResultType[T any](e *entity) error {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if !isStructType(t) || t.Kind() != reflect.Struct || len(e.columns) == 0 {
+		return nil
+	}
+	key := resultTypeKey{entity: e.getColumnsKey(), t: t}
+	if cached, ok := resultTypesCache.Load(key); ok {
+		if cached == nil {
+			return nil
+		}
+		return cached.(error)
+	}
+	var err error
+	if !hasColumnField(getStructFields(t), e) {
+		err = fmt.Errorf("%w: %s for %s", ErrResultTypeMismatch, t, e.name)
+	}
+	resultTypesCache.Store(key, err)
+	return err
+}
+
+func hasColumnField(fields *structFields, e *entity) bool {
+	for _, c := range e.columns {
+		if fields.get(c.name) != nil {
+			return true
+		}
+	}
+	return false
+}
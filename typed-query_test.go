@@ -0,0 +1,69 @@
+package land
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testUnrelatedModel struct {
+	Title string
+}
+
+func TestValidateResultType(t *testing.T) {
+	test := assert.New(t)
+	e := testEntity(testCreatePostgresInstance()).getPtr()
+	test.NoError(validateResultType[testModel](e))
+	test.NoError(validateResultType[map[string]any](e))
+	test.NoError(validateResultType[int](e))
+	test.NoError(validateResultType[Null[string]](e))
+	err := validateResultType[testUnrelatedModel](e)
+	test.ErrorIs(err, ErrResultTypeMismatch)
+	test.Same(err, validateResultType[testUnrelatedModel](e))
+}
+
+func TestAllResultTypeMismatch(t *testing.T) {
+	test := assert.New(t)
+	q := testEntity(testCreatePostgresInstance()).Select()
+	result, err := All[testUnrelatedModel](context.Background(), q)
+	test.ErrorIs(err, ErrResultTypeMismatch)
+	test.Nil(result)
+	_, err = One[testUnrelatedModel](context.Background(), q)
+	test.ErrorIs(err, ErrResultTypeMismatch)
+}
+
+func TestScanSliceOfScalars(t *testing.T) {
+	test := assert.New(t)
+	result := make([]int, 0)
+	m := createQueryManager(nil, nil).setDest(&result)
+	for _, id := range []int{1, 2} {
+		row := m.createRowDataModel()
+		m.setValue(row, Id, id)
+		m.setRowDataToResult(row)
+	}
+	test.Equal([]int{1, 2}, result)
+	m.setRowDataToResult(reflect.ValueOf([]int{3, 4}))
+	test.Equal([]int{3, 4}, result)
+}
+
+func TestAllWithoutDefaultLimit(t *testing.T) {
+	test := assert.New(t)
+	l, fake := testFakeLand(t)
+	_, err := All[testModel](context.Background(), testEntity(l).Select())
+	test.NoError(err)
+	_, err = All[testModel](context.Background(), testEntity(l).Select().Limit(5))
+	test.NoError(err)
+	test.NoError(Iterate(context.Background(), testEntity(l).Select(), func(row testModel) error {
+		return nil
+	}))
+	test.Equal(
+		[]string{
+			`SELECT * FROM "tests" AS "t";`,
+			`SELECT * FROM "tests" AS "t" LIMIT 5;`,
+			`SELECT * FROM "tests" AS "t";`,
+		},
+		fake.getQueries(),
+	)
+}
@@ -2,40 +2,58 @@ package land
 
 import (
 	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
 	"reflect"
 	"slices"
 	"strings"
-	
-	"github.com/iancoleman/strcase"
 )
 
 type UpdateQuery interface {
+	Context(context context.Context) UpdateQuery
 	SetColumns(columns ...string) UpdateQuery
 	SetValues(value any) UpdateQuery
+	SetRows(rows any, keyColumn string) UpdateQuery
 	GetSQL() string
+	GetSQLWithArgs() (string, []any)
 	GetResult(dest any)
+	ScanE(ctx context.Context, dest any) error
 	Exec()
+	ExecE(ctx context.Context) (sql.Result, error)
 	SetVectors(values ...any) UpdateQuery
 	Return(columns ...string) UpdateQuery
 	Where(entity ...Entity) ConditionQuery
+	WithDeleted() UpdateQuery
+	Restore() UpdateQuery
 }
 
 type updateQueryBuilder struct {
 	*queryBuilder
 	entity   *entity
 	context  context.Context
+	value    any
 	data     ref
+	rows     []ref
+	key      string
 	wheres   []*conditionQueryBuilder
 	vectors  string
+	isVector bool
 	returns  []string
 	columns  []string
 	isReturn bool
+	deleted  string
+	restore  bool
 }
 
 var (
 	updateQueryReservedColumns = []string{UpdatedAt, Vectors}
 )
 
+const (
+	updateRowsAlias = "v"
+)
+
 func createUpdateQuery(entity *entity) *updateQueryBuilder {
 	return &updateQueryBuilder{
 		queryBuilder: createQueryBuilder().setQueryType(Update),
@@ -46,19 +64,92 @@ func createUpdateQuery(entity *entity) *updateQueryBuilder {
 	}
 }
 
+func (q *updateQueryBuilder) Context(context context.Context) UpdateQuery {
+	q.context = context
+	return q
+}
+
 func (q *updateQueryBuilder) GetSQL() string {
-	return q.createQueryString()
+	query, _ := q.GetSQLWithArgs()
+	return query
+}
+
+func (q *updateQueryBuilder) GetSQLWithArgs() (string, []any) {
+	q.resetArgs()
+	return q.createQueryString(), q.getArgs()
 }
 
 func (q *updateQueryBuilder) GetResult(dest any) {
-	createQueryManager(q.entity, q.context).setQuery(q.GetSQL()).setQueryType(Update).setDest(dest).getResult()
+	if q.isEmptyRows() {
+		return
+	}
+	q.entity.errorManager.check(q.checkRows(), "")
+	q.entity.errorManager.check(q.entity.runBeforeUpdate(q.context, q, q.value, q.getHookRows()), "")
+	query, args := q.GetSQLWithArgs()
+	m := createQueryManager(q.entity, q.context).setQuery(query).setArgs(args).setQueryType(Update).setCheckStale(q.isVersioned())
+	if q.isStaleByRowsAffected() {
+		m.exec()
+	} else {
+		m.setDest(dest).getResult()
+	}
+	q.entity.errorManager.check(q.entity.runAfterUpdate(q.context, q, q.value, q.getHookRows()), "")
+}
+
+func (q *updateQueryBuilder) ExecE(ctx context.Context) (sql.Result, error) {
+	if q.isEmptyRows() {
+		return driver.RowsAffected(0), nil
+	}
+	if err := q.checkRows(); err != nil {
+		return nil, err
+	}
+	if err := q.entity.runBeforeUpdate(ctx, q, q.value, q.getHookRows()); err != nil {
+		return nil, err
+	}
+	query, args := q.GetSQLWithArgs()
+	result, err := createQueryManager(q.entity, ctx).setQuery(query).setArgs(args).setQueryType(Update).setCheckStale(q.isVersioned()).execE()
+	if err != nil {
+		return result, err
+	}
+	return result, q.entity.runAfterUpdate(ctx, q, q.value, q.getHookRows())
+}
+
+func (q *updateQueryBuilder) ScanE(ctx context.Context, dest any) error {
+	if q.isEmptyRows() {
+		return nil
+	}
+	if err := q.checkRows(); err != nil {
+		return err
+	}
+	if err := q.entity.runBeforeUpdate(ctx, q, q.value, q.getHookRows()); err != nil {
+		return err
+	}
+	query, args := q.GetSQLWithArgs()
+	m := createQueryManager(q.entity, ctx).setQuery(query).setArgs(args).setQueryType(Update).setCheckStale(q.isVersioned())
+	var err error
+	if q.isStaleByRowsAffected() {
+		_, err = m.execE()
+	} else {
+		err = m.setDest(dest).getResultE()
+	}
+	if err != nil {
+		return err
+	}
+	return q.entity.runAfterUpdate(ctx, q, q.value, q.getHookRows())
 }
 
 func (q *updateQueryBuilder) Exec() {
-	createQueryManager(q.entity, q.context).setQuery(q.GetSQL()).setQueryType(Update).exec()
+	if q.isEmptyRows() {
+		return
+	}
+	q.entity.errorManager.check(q.checkRows(), "")
+	q.entity.errorManager.check(q.entity.runBeforeUpdate(q.context, q, q.value, q.getHookRows()), "")
+	query, args := q.GetSQLWithArgs()
+	createQueryManager(q.entity, q.context).setQuery(query).setArgs(args).setQueryType(Update).setCheckStale(q.isVersioned()).exec()
+	q.entity.errorManager.check(q.entity.runAfterUpdate(q.context, q, q.value, q.getHookRows()), "")
 }
 
 func (q *updateQueryBuilder) SetValues(data any) UpdateQuery {
+	q.value = data
 	q.data.t = reflect.TypeOf(data)
 	q.data.v = reflect.ValueOf(data)
 	q.data.kind = q.data.v.Kind()
@@ -68,6 +159,34 @@ func (q *updateQueryBuilder) SetValues(data any) UpdateQuery {
 	return q
 }
 
+func (q *updateQueryBuilder) SetRows(rows any, keyColumn string) UpdateQuery {
+	v := reflect.ValueOf(rows)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	q.value = rows
+	q.rows = make([]ref, 0)
+	q.key = keyColumn
+	if v.Kind() != reflect.Slice {
+		return q
+	}
+	for i := 0; i < v.Len(); i++ {
+		q.rows = append(q.rows, createDataRef(v.Index(i)))
+	}
+	return q
+}
+
+func (q *updateQueryBuilder) WithDeleted() UpdateQuery {
+	q.deleted = deletedInclude
+	return q
+}
+
+func (q *updateQueryBuilder) Restore() UpdateQuery {
+	q.deleted = deletedOnly
+	q.restore = true
+	return q
+}
+
 func (q *updateQueryBuilder) SetColumns(columns ...string) UpdateQuery {
 	q.columns = columns
 	return q
@@ -81,6 +200,7 @@ func (q *updateQueryBuilder) Return(columns ...string) UpdateQuery {
 
 func (q *updateQueryBuilder) SetVectors(values ...any) UpdateQuery {
 	q.vectors = createTSVectors(values...)
+	q.isVector = true
 	return q
 }
 
@@ -94,11 +214,49 @@ func (q *updateQueryBuilder) Where(entity ...Entity) ConditionQuery {
 	return where
 }
 
+func (q *updateQueryBuilder) getHookRows() []ref {
+	if len(q.key) > 0 {
+		return q.rows
+	}
+	return []ref{q.data}
+}
+
+func (q *updateQueryBuilder) isEmptyRows() bool {
+	return len(q.key) > 0 && len(q.rows) == 0
+}
+
+func (q *updateQueryBuilder) checkRows() error {
+	if len(q.key) == 0 {
+		return nil
+	}
+	if !slices.ContainsFunc(q.entity.columns, func(c *column) bool { return c.name == q.key && q.isRowsColumnComplete(c) }) {
+		return fmt.Errorf("%w: %s", ErrMissingKeyColumn, q.key)
+	}
+	for _, c := range q.getRowsColumns() {
+		for _, row := range q.rows {
+			if !q.isRowsColumnIncluded(c, row) {
+				continue
+			}
+			field, _ := q.getDataField(row, c)
+			if len(createQueryBuilder().setQueryType(Update).createValue(c, field)) == 0 {
+				return fmt.Errorf("%w: %s", ErrValueType, c.name)
+			}
+		}
+	}
+	return nil
+}
+
 func (q *updateQueryBuilder) createQueryString() string {
 	result := make([]string, 0)
 	result = append(result, "UPDATE", q.escape(q.entity.name), "AS", q.escape(q.entity.alias))
+	if len(q.key) > 0 {
+		result = append(result, q.createRowsPart()...)
+		result = append(result, q.createReturnPart()...)
+		return strings.Join(result, " ") + q.getQueryDivider()
+	}
 	result = append(result, "SET", q.createSetsPart())
 	result = append(result, q.createWheresPart()...)
+	result = append(result, q.createScopesPart()...)
 	result = append(result, q.createReturnPart()...)
 	return strings.Join(result, " ") + q.getQueryDivider()
 }
@@ -106,10 +264,18 @@ func (q *updateQueryBuilder) createQueryString() string {
 func (q *updateQueryBuilder) createSetsPart() string {
 	result := make([]string, 0)
 	for _, c := range q.entity.columns {
+		if c.name == DeletedAt && q.restore {
+			result = append(result, q.escape(c.name)+" = NULL")
+			continue
+		}
+		if c.name == Version && q.data.v.IsValid() {
+			result = append(result, fmt.Sprintf("%[1]s = %[1]s + 1", q.escape(c.name)))
+			continue
+		}
 		if len(q.columns) > 0 && !slices.Contains(q.columns, c.name) {
 			continue
 		}
-		if c.name == Id || c.name == CreatedAt || !q.data.v.IsValid() || (c.name == Vectors && len(q.vectors) == 0) {
+		if c.name == Id || c.name == CreatedAt || c.name == DeletedAt || !q.data.v.IsValid() || (c.name == Vectors && !q.isVector) {
 			continue
 		}
 		setSql := make([]string, 0)
@@ -119,41 +285,169 @@ func (q *updateQueryBuilder) createSetsPart() string {
 			case UpdatedAt:
 				setSql = append(setSql, CurrentTimestamp)
 			case Vectors:
-				setSql = append(setSql, q.vectors)
+				setSql = append(setSql, q.createTSVectorsValue(q.vectors))
 			}
 			result = append(result, strings.Join(setSql, " "))
 			continue
 		}
-		var field reflect.Value
-		switch q.data.kind {
-		case reflect.Map:
-			field = q.getMapValue(q.data.v, c.name)
-		case reflect.Struct:
-			field = q.data.v.FieldByName(strcase.ToCamel(c.name))
+		field, structField := q.getDataField(q.data, c)
+		if !field.IsValid() || q.shouldSkipField(field, structField) {
+			continue
 		}
-		if !field.IsValid() {
+		setSql = append(setSql, q.createValue(c, field))
+		result = append(result, strings.Join(setSql, " "))
+	}
+	return strings.Join(result, q.getColumnsDivider())
+}
+
+func (q *updateQueryBuilder) createScopesPart() []string {
+	result := make([]string, 0)
+	scopes := make([]*conditionQueryBuilder, 0)
+	if deleted := createDeletedCondition(q.entity, q.deleted); deleted != nil {
+		scopes = append(scopes, deleted)
+	}
+	if version := q.getVersion(); version.IsValid() {
+		where := createConditionQuery(q.entity)
+		where.Column(Version).Equal(version.Interface())
+		scopes = append(scopes, where)
+	}
+	for i, scope := range scopes {
+		if i == 0 && len(q.wheres) == 0 {
+			result = append(result, "WHERE")
+		} else {
+			result = append(result, "AND")
+		}
+		q.shareArgs(scope.queryBuilder)
+		result = append(result, scope.createQueryString())
+	}
+	return result
+}
+
+func (q *updateQueryBuilder) getVersion() reflect.Value {
+	if len(q.key) > 0 || !q.data.v.IsValid() || !q.entity.hasColumn(Version) {
+		return reflect.Value{}
+	}
+	field, _ := q.getDataField(q.data, &column{name: Version, dataType: Int})
+	if !field.IsValid() || isNullValue(field) {
+		return reflect.Value{}
+	}
+	if field.Kind() == reflect.Interface || field.Kind() == reflect.Ptr {
+		field = field.Elem()
+	}
+	return field
+}
+
+func (q *updateQueryBuilder) isVersioned() bool {
+	return q.getVersion().IsValid()
+}
+
+func (q *updateQueryBuilder) isStaleByRowsAffected() bool {
+	return q.isVersioned() && !q.isReturn
+}
+
+func (q *updateQueryBuilder) createRowsPart() []string {
+	columns := q.getRowsColumns()
+	columnsSql := make([]string, len(columns))
+	sets := make([]string, 0)
+	for i, c := range columns {
+		columnsSql[i] = q.escape(c.name)
+		if c.name == q.key {
 			continue
 		}
-		if field.IsZero() {
-			setSql = append(setSql, q.createZeroValue(c, field))
+		value := q.escape(updateRowsAlias) + q.getCoupler() + q.escape(c.name)
+		if !q.isRowsColumnComplete(c) {
+			value = fmt.Sprintf("COALESCE(%s,%s)", value, q.escape(q.entity.alias)+q.getCoupler()+q.escape(c.name))
+		}
+		sets = append(sets, q.escape(c.name)+" = "+value)
+	}
+	for _, c := range q.entity.columns {
+		if c.name == UpdatedAt {
+			sets = append(sets, q.escape(c.name)+" = "+CurrentTimestamp)
+		}
+		if c.name == DeletedAt && q.restore {
+			sets = append(sets, q.escape(c.name)+" = NULL")
 		}
-		if !field.IsZero() {
-			setSql = append(setSql, q.createValue(c, field))
+		if c.name == Version {
+			sets = append(sets, fmt.Sprintf("%[1]s = %[2]s%[3]s%[1]s + 1", q.escape(c.name), q.escape(q.entity.alias), q.getCoupler()))
 		}
-		result = append(result, strings.Join(setSql, " "))
 	}
-	return strings.Join(result, q.getColumnsDivider())
+	values := make([]string, len(q.rows))
+	for i, row := range q.rows {
+		rowValues := make([]string, len(columns))
+		for j, c := range columns {
+			rowValues[j] = q.createRowValue(c, row)
+		}
+		values[i] = "(" + strings.Join(rowValues, q.getColumnsDivider()) + ")"
+	}
+	key := q.escape(q.entity.alias) + q.getCoupler() + q.escape(q.key) + " = " + q.escape(updateRowsAlias) + q.getCoupler() + q.escape(q.key)
+	result := make([]string, 0)
+	result = append(result, "SET", strings.Join(sets, q.getColumnsDivider()))
+	result = append(result, "FROM", "(VALUES", strings.Join(values, q.getColumnsDivider())+")")
+	result = append(result, "AS", q.escape(updateRowsAlias)+"("+strings.Join(columnsSql, q.getColumnsDivider())+")")
+	result = append(result, "WHERE", key)
+	for _, where := range q.wheres {
+		if where.excludeFromZeroLevel {
+			continue
+		}
+		q.shareArgs(where.queryBuilder)
+		result = append(result, "AND", where.createQueryString())
+	}
+	if deleted := createDeletedCondition(q.entity, q.deleted); deleted != nil {
+		result = append(result, "AND", deleted.createQueryString())
+	}
+	return result
+}
+
+func (q *updateQueryBuilder) getRowsColumns() []*column {
+	result := make([]*column, 0)
+	for _, c := range q.entity.columns {
+		if c.name != q.key && (len(q.columns) > 0 && !slices.Contains(q.columns, c.name)) {
+			continue
+		}
+		if c.name != q.key && slices.Contains([]string{Id, CreatedAt, UpdatedAt, Vectors, Version, DeletedAt}, c.name) {
+			continue
+		}
+		for _, row := range q.rows {
+			if q.isRowsColumnIncluded(c, row) {
+				result = append(result, c)
+				break
+			}
+		}
+	}
+	return result
+}
+
+func (q *updateQueryBuilder) isRowsColumnIncluded(c *column, row ref) bool {
+	field, structField := q.getDataField(row, c)
+	return field.IsValid() && !q.shouldSkipField(field, structField)
+}
+
+func (q *updateQueryBuilder) isRowsColumnComplete(c *column) bool {
+	for _, row := range q.rows {
+		if !q.isRowsColumnIncluded(c, row) {
+			return false
+		}
+	}
+	return true
 }
 
-func (q *updateQueryBuilder) createZeroValue(c *column, field reflect.Value) string {
-	if !c.options.NotNull {
-		return "NULL"
+func (q *updateQueryBuilder) createRowValue(c *column, row ref) string {
+	value := "NULL"
+	if q.isRowsColumnIncluded(c, row) {
+		field, _ := q.getDataField(row, c)
+		value = q.createValue(c, field)
 	}
+	return value + "::" + q.createCastType(c)
+}
+
+func (q *updateQueryBuilder) createCastType(c *column) string {
 	switch c.dataType {
-	case Boolean:
-		return "false"
+	case Serial:
+		return strings.ToUpper(Int)
+	case TimestampWithZone:
+		return "TIMESTAMPTZ"
 	default:
-		return q.createValue(c, field)
+		return q.createDataType(c)
 	}
 }
 
@@ -163,6 +457,10 @@ func (q *updateQueryBuilder) createReturnPart() []string {
 		return result
 	}
 	result = append(result, "RETURNING")
+	if len(q.returns) == 0 && len(q.key) > 0 {
+		result = append(result, q.escape(q.entity.alias)+q.getCoupler()+"*")
+		return result
+	}
 	if len(q.returns) == 0 {
 		result = append(result, "*")
 		return result
@@ -170,6 +468,9 @@ func (q *updateQueryBuilder) createReturnPart() []string {
 	returnCols := make([]string, len(q.returns))
 	for i, r := range q.returns {
 		returnCols[i] = q.escape(r)
+		if len(q.key) > 0 {
+			returnCols[i] = q.escape(q.entity.alias) + q.getCoupler() + returnCols[i]
+		}
 	}
 	result = append(result, strings.Join(returnCols, q.getColumnsDivider()))
 	return result
@@ -188,6 +489,7 @@ func (q *updateQueryBuilder) createWheresPart() []string {
 		if i > 0 {
 			condition = append(condition, "AND")
 		}
+		q.shareArgs(where.queryBuilder)
 		condition = append(condition, where.createQueryString())
 		result = append(result, strings.Join(condition, " "))
 	}
@@ -1,6 +1,8 @@
 package land
 
 import (
+	"context"
+	"database/sql/driver"
 	"testing"
 	
 	"github.com/stretchr/testify/assert"
@@ -18,10 +20,12 @@ func TestUpdate(t *testing.T) {
 		).
 		SetVectors("Dominik", "Linduska")
 	q.Return(Id, "name", "lastname")
+	query, args := q.GetSQLWithArgs()
 	test.Equal(
-		`UPDATE "tests" AS "t" SET "name" = 'Dominik',"lastname" = 'Linduska',"active" = false,"vectors" = to_tsvector('dominik linduska'),"updated_at" = CURRENT_TIMESTAMP RETURNING "id","name","lastname";`,
-		q.GetSQL(),
+		`UPDATE "tests" AS "t" SET "name" = $1,"lastname" = $2,"active" = $3,"vectors" = to_tsvector($4),"updated_at" = CURRENT_TIMESTAMP RETURNING "id","name","lastname";`,
+		query,
 	)
+	test.Equal([]any{"Dominik", "Linduska", false, "dominik linduska"}, args)
 }
 
 func TestUpdateMapValue(t *testing.T) {
@@ -32,8 +36,124 @@ func TestUpdateMapValue(t *testing.T) {
 			map[string]any{"name": "Dominik", "lastname": "Linduska"},
 		)
 	q.Return(Id, "name", "lastname")
+	query, args := q.GetSQLWithArgs()
 	test.Equal(
-		`UPDATE "tests" AS "t" SET "name" = 'Dominik',"lastname" = 'Linduska',"updated_at" = CURRENT_TIMESTAMP RETURNING "id","name","lastname";`,
-		q.GetSQL(),
+		`UPDATE "tests" AS "t" SET "name" = $1,"lastname" = $2,"updated_at" = CURRENT_TIMESTAMP RETURNING "id","name","lastname";`,
+		query,
 	)
+	test.Equal([]any{"Dominik", "Linduska"}, args)
+}
+
+func TestUpdateSetRows(t *testing.T) {
+	test := assert.New(t)
+	rows := []map[string]any{
+		{"id": 1, "name": "Dominik", "lastname": "Linduska"},
+		{"id": 2, "name": "Conan"},
+	}
+	q := testEntity(testCreatePostgresInstance()).Update().SetRows(rows, Id)
+	q.Where().Column(testActive).Equal(true)
+	q.Return(Id, testName)
+	query, args := q.GetSQLWithArgs()
+	test.Equal(
+		`UPDATE "tests" AS "t" SET "name" = "v"."name","lastname" = COALESCE("v"."lastname","t"."lastname"),"updated_at" = CURRENT_TIMESTAMP FROM (VALUES ($1::INT,$2::VARCHAR(255),$3::VARCHAR(255)),($4::INT,$5::VARCHAR(255),NULL::VARCHAR(255))) AS "v"("id","name","lastname") WHERE "t"."id" = "v"."id" AND "t"."active" = $6 RETURNING "t"."id","t"."name";`,
+		query,
+	)
+	test.Equal([]any{int64(1), "Dominik", "Linduska", int64(2), "Conan", true}, args)
+}
+
+func TestUpdateSetRowsColumns(t *testing.T) {
+	test := assert.New(t)
+	rows := []testModel{{Name: "Dominik", Active: true}, {Name: "Conan"}}
+	q := testEntity(testCreatePostgresInstance()).Update().SetRows(rows, testName).SetColumns(testActive).Return()
+	query, args := q.GetSQLWithArgs()
+	test.Equal(
+		`UPDATE "tests" AS "t" SET "active" = "v"."active","updated_at" = CURRENT_TIMESTAMP FROM (VALUES ($1::VARCHAR(255),$2::BOOLEAN),($3::VARCHAR(255),$4::BOOLEAN)) AS "v"("name","active") WHERE "t"."name" = "v"."name" RETURNING "t".*;`,
+		query,
+	)
+	test.Equal([]any{"Dominik", true, "Conan", false}, args)
+}
+
+func TestUpdateSetRowsExec(t *testing.T) {
+	test := assert.New(t)
+	l, fake := testFakeLand(t)
+	result, err := testEntity(l).Update().SetRows([]testModel{}, Id).ExecE(context.Background())
+	test.NoError(err)
+	count, _ := result.RowsAffected()
+	test.Equal(int64(0), count)
+	test.Empty(fake.getQueries())
+	rows := []map[string]any{{"id": 1, "name": "Dominik"}, {"name": "Conan"}}
+	_, err = testEntity(l).Update().SetRows(rows, Id).ExecE(context.Background())
+	test.ErrorIs(err, ErrMissingKeyColumn)
+	rows = []map[string]any{{"id": 1, "active": true}, {"id": 2, "active": "yes"}}
+	_, err = testEntity(l).Update().SetRows(rows, Id).ExecE(context.Background())
+	test.ErrorIs(err, ErrValueType)
+	test.ErrorContains(err, testActive)
+	test.Panics(func() {
+		testEntity(l).Update().SetRows([]testModel{{Name: "Dominik"}}, "email").Exec()
+	})
+	test.Empty(fake.getQueries())
+}
+
+func TestUpdateVersion(t *testing.T) {
+	test := assert.New(t)
+	q := testEntity(testCreatePostgresInstance()).
+		SetVersion().
+		Update().
+		SetValues(map[string]any{"name": "Dominik", "version": 3})
+	q.Where().Column(Id).Equal(1)
+	query, args := q.GetSQLWithArgs()
+	test.Equal(
+		`UPDATE "tests" AS "t" SET "name" = $1,"updated_at" = CURRENT_TIMESTAMP,"version" = "version" + 1 WHERE "t"."id" = $2 AND "t"."version" = $3;`,
+		query,
+	)
+	test.Equal([]any{"Dominik", int64(1), int64(3)}, args)
+}
+
+func TestUpdateVersionStale(t *testing.T) {
+	test := assert.New(t)
+	l, _ := testFakeLand(t)
+	q := testEntity(l).
+		SetVersion().
+		Update().
+		SetValues(map[string]any{"name": "Dominik", "version": 3})
+	_, err := q.ExecE(context.Background())
+	test.ErrorIs(err, ErrStaleObject)
+	_, err = testEntity(l).SetVersion().Update().SetValues(map[string]any{"name": "Dominik"}).ExecE(context.Background())
+	test.NoError(err)
+}
+
+func TestUpdateVersionInt64(t *testing.T) {
+	test := assert.New(t)
+	q := testEntity(testCreatePostgresInstance()).
+		SetVersion().
+		Update().
+		SetValues(struct {
+			Name    string
+			Version int64
+		}{Name: "Dominik", Version: 3})
+	query, args := q.GetSQLWithArgs()
+	test.Equal(
+		`UPDATE "tests" AS "t" SET "name" = $1,"updated_at" = CURRENT_TIMESTAMP,"version" = "version" + 1 WHERE "t"."version" = $2;`,
+		query,
+	)
+	test.Equal([]any{"Dominik", int64(3)}, args)
+}
+
+func TestUpdateVersionScan(t *testing.T) {
+	test := assert.New(t)
+	l, fake := testFakeLand(t)
+	update := func() UpdateQuery {
+		return testEntity(l).
+			SetVersion().
+			Update().
+			SetValues(map[string]any{"name": "Dominik", "version": uint(3)})
+	}
+	var result []testModel
+	fake.setRowsAffected(1)
+	test.NoError(update().ScanE(context.Background(), &result))
+	fake.setRowsAffected(0)
+	test.ErrorIs(update().ScanE(context.Background(), &result), ErrStaleObject)
+	fake.setResult("tests", []string{"id", "name"}, []driver.Value{int64(1), "Dominik"})
+	test.NoError(update().Return().ScanE(context.Background(), &result))
+	test.Len(result, 1)
 }
@@ -20,10 +20,13 @@ func latinize(value string) string {
 	return result
 }
 
+var (
+	specialCharactersReplacer = regexp.MustCompile(`[-_.,=&;@/(){}]`)
+	marksReplacer             = strings.NewReplacer("'", "’")
+)
+
 func replaceSpecialCharacters(value string) string {
-	replacer := regexp.MustCompile(`[-_.,=&;@/(){}]`)
-	marksReplacer := strings.NewReplacer("'", "’")
-	value = replacer.ReplaceAllString(value, " ")
+	value = specialCharactersReplacer.ReplaceAllString(value, " ")
 	value = marksReplacer.Replace(value)
 	return value
 }
@@ -8,13 +8,20 @@ import (
 
 type ConditionQuery interface {
 	And(queries ...ConditionQuery) ConditionQuery
+	Any(value any) ConditionQuery
+	ArrayContains(value any) ConditionQuery
 	Column(column string) ConditionQuery
+	ContainedBy(value any) ConditionQuery
 	Contains(value any) ConditionQuery
 	Equal(value any) ConditionQuery
+	HasKey(key string) ConditionQuery
+	JsonContains(value any) ConditionQuery
 	Like(value any) ConditionQuery
 	Not() ConditionQuery
 	Null() ConditionQuery
 	Or(queries ...ConditionQuery) ConditionQuery
+	Overlaps(value any) ConditionQuery
+	Path(keys ...string) ConditionQuery
 	Subquery(subquery SelectQuery) ConditionQuery
 	Use(use bool) ConditionQuery
 	Webalize() ConditionQuery
@@ -30,7 +37,8 @@ type conditionQueryBuilder struct {
 	andQueries           []*conditionQueryBuilder
 	whereType            string
 	column               string
-	subquery             string
+	path                 []string
+	subquery             *selectQueryBuilder
 	valueRef             ref
 	excludeFromZeroLevel bool
 	use                  bool
@@ -39,11 +47,17 @@ type conditionQueryBuilder struct {
 }
 
 const (
-	whereContains = "contains"
-	whereEqual    = "equal"
-	whereFulltext = "fulltext"
-	whereLike     = "like"
-	whereNull     = "null"
+	whereAny           = "any"
+	whereArrayContains = "arrayContains"
+	whereContainedBy   = "containedBy"
+	whereContains      = "contains"
+	whereEqual         = "equal"
+	whereFulltext      = "fulltext"
+	whereHasKey        = "hasKey"
+	whereJsonContains  = "jsonContains"
+	whereLike          = "like"
+	whereNull          = "null"
+	whereOverlaps      = "overlaps"
 )
 
 func createConditionQuery(entity *entity) *conditionQueryBuilder {
@@ -65,11 +79,29 @@ func (q *conditionQueryBuilder) And(queries ...ConditionQuery) ConditionQuery {
 	return q
 }
 
+func (q *conditionQueryBuilder) Any(value any) ConditionQuery {
+	q.whereType = whereAny
+	q.createValueRef(value)
+	return q
+}
+
+func (q *conditionQueryBuilder) ArrayContains(value any) ConditionQuery {
+	q.whereType = whereArrayContains
+	q.createValueRef(value)
+	return q
+}
+
 func (q *conditionQueryBuilder) Column(column string) ConditionQuery {
 	q.column = column
 	return q
 }
 
+func (q *conditionQueryBuilder) ContainedBy(value any) ConditionQuery {
+	q.whereType = whereContainedBy
+	q.createValueRef(value)
+	return q
+}
+
 func (q *conditionQueryBuilder) Contains(value any) ConditionQuery {
 	q.whereType = whereContains
 	q.createValueRef(value)
@@ -82,6 +114,18 @@ func (q *conditionQueryBuilder) Equal(value any) ConditionQuery {
 	return q
 }
 
+func (q *conditionQueryBuilder) HasKey(key string) ConditionQuery {
+	q.whereType = whereHasKey
+	q.createValueRef(key)
+	return q
+}
+
+func (q *conditionQueryBuilder) JsonContains(value any) ConditionQuery {
+	q.whereType = whereJsonContains
+	q.createValueRef(value)
+	return q
+}
+
 func (q *conditionQueryBuilder) Like(value any) ConditionQuery {
 	q.whereType = whereLike
 	q.valueRef.t = reflect.TypeOf(value)
@@ -109,8 +153,19 @@ func (q *conditionQueryBuilder) Or(queries ...ConditionQuery) ConditionQuery {
 	return q
 }
 
+func (q *conditionQueryBuilder) Overlaps(value any) ConditionQuery {
+	q.whereType = whereOverlaps
+	q.createValueRef(value)
+	return q
+}
+
+func (q *conditionQueryBuilder) Path(keys ...string) ConditionQuery {
+	q.path = keys
+	return q
+}
+
 func (q *conditionQueryBuilder) Subquery(query SelectQuery) ConditionQuery {
-	q.subquery = strings.TrimSuffix(query.GetSQL(), q.getQueryDivider())
+	q.subquery = query.getPtr()
 	return q
 }
 
@@ -133,40 +188,23 @@ func (q *conditionQueryBuilder) createValueRef(value any) {
 
 func (q *conditionQueryBuilder) createQueryString() string {
 	shouldBeGrouped := len(q.orQueries) > 0 || len(q.andQueries) > 0
-	subqueryExist := len(q.subquery) > 0
+	subqueryExist := q.subquery != nil
 	result := make([]string, 0)
-	if subqueryExist {
-		result = append(result, fmt.Sprintf("(%s)", q.subquery))
-	}
-	if !shouldBeGrouped {
-		if !subqueryExist {
-			columnSql := make([]string, 0)
-			if len(q.entity.alias) > 0 {
-				columnSql = append(columnSql, q.escape(q.entity.alias)+q.getCoupler())
-			}
-			columnSql = append(columnSql, q.escape(q.column))
-			col := strings.Join(columnSql, "")
-			if q.webalize {
-				col = webalize(col)
-			}
-			result = append(result, col)
-		}
-		result = append(result, q.getOperator())
-		value := q.getValue()
-		if len(value) > 0 {
-			result = append(result, value)
-		}
+	if !shouldBeGrouped || len(q.column) > 0 || subqueryExist {
+		result = append(result, q.createConditionPart())
 	}
-	for i, item := range q.andQueries {
-		if (i != 0 && shouldBeGrouped) || !shouldBeGrouped {
+	for _, item := range q.andQueries {
+		if len(result) > 0 {
 			result = append(result, "AND")
 		}
+		q.shareArgs(item.queryBuilder)
 		result = append(result, item.createQueryString())
 	}
-	for i, item := range q.orQueries {
-		if (i != 0 && shouldBeGrouped) || !shouldBeGrouped {
+	for _, item := range q.orQueries {
+		if len(result) > 0 {
 			result = append(result, "OR")
 		}
+		q.shareArgs(item.queryBuilder)
 		result = append(result, item.createQueryString())
 	}
 	resultStr := strings.Join(result, " ")
@@ -176,6 +214,48 @@ func (q *conditionQueryBuilder) createQueryString() string {
 	return resultStr
 }
 
+func (q *conditionQueryBuilder) createConditionPart() string {
+	left := q.createLeftPart()
+	value := q.getValue()
+	if q.whereType == whereAny {
+		return q.createAnyPart(left, value)
+	}
+	result := []string{left, q.getOperator()}
+	if len(value) > 0 {
+		result = append(result, value)
+	}
+	return strings.Join(result, " ")
+}
+
+func (q *conditionQueryBuilder) createLeftPart() string {
+	if q.subquery != nil {
+		q.shareArgs(q.subquery.queryBuilder)
+		return fmt.Sprintf("(%s)", q.subquery.createQueryString())
+	}
+	columnSql := make([]string, 0)
+	if len(q.entity.alias) > 0 {
+		columnSql = append(columnSql, q.escape(q.entity.alias)+q.getCoupler())
+	}
+	columnSql = append(columnSql, q.escape(q.column))
+	columnSql = append(columnSql, q.createPathPart()...)
+	col := strings.Join(columnSql, "")
+	if q.webalize {
+		col = webalize(col)
+	}
+	return col
+}
+
+func (q *conditionQueryBuilder) createAnyPart(left, value string) string {
+	operator, quantifier := "=", "ANY"
+	if q.negation {
+		operator, quantifier = "!=", "ALL"
+	}
+	if q.isArrayColumn() {
+		return fmt.Sprintf("%s %s %s(%s)", value, operator, quantifier, left)
+	}
+	return fmt.Sprintf("%s %s %s(%s)", left, operator, quantifier, value)
+}
+
 func (q *conditionQueryBuilder) getOperator() string {
 	switch q.whereType {
 	case whereContains:
@@ -190,6 +270,14 @@ func (q *conditionQueryBuilder) getOperator() string {
 		return "="
 	case whereFulltext:
 		return "@@"
+	case whereHasKey:
+		return "?"
+	case whereJsonContains, whereArrayContains:
+		return "@>"
+	case whereContainedBy:
+		return "<@"
+	case whereOverlaps:
+		return "&&"
 	case whereLike:
 		if q.negation {
 			return "NOT LIKE"
@@ -207,16 +295,26 @@ func (q *conditionQueryBuilder) getOperator() string {
 
 func (q *conditionQueryBuilder) getValue() string {
 	column := q.getColumn()
-	if column == nil && len(q.subquery) == 0 {
+	if q.whereType == whereAny {
+		return q.createAnyConditionValue()
+	}
+	if column == nil && q.subquery == nil {
 		return ""
 	}
-	if column == nil && len(q.subquery) > 0 {
+	if column == nil && q.subquery != nil {
 		return q.createValueWithUnknownColumn(q.valueRef)
 	}
 	if q.valueRef.safe {
 		return fmt.Sprintf("%s", q.valueRef.v.Interface().(Safe).Value)
 	}
-	value := q.createValue(column, q.valueRef.v)
+	var value string
+	if len(q.path) > 0 && q.whereType == whereJsonContains {
+		value = q.bind(jsonValue{value: q.valueRef.v.Interface()})
+	} else if len(q.path) > 0 || q.whereType == whereHasKey {
+		value = q.createPlainValue()
+	} else {
+		value = q.createValue(column, q.valueRef.v)
+	}
 	if q.webalize {
 		value = webalize(value)
 	}
@@ -226,6 +324,43 @@ func (q *conditionQueryBuilder) getValue() string {
 	return value
 }
 
+func (q *conditionQueryBuilder) createPathPart() []string {
+	result := make([]string, len(q.path))
+	for i, key := range q.path {
+		operator := "->"
+		if i == len(q.path)-1 && q.whereType != whereHasKey && q.whereType != whereJsonContains {
+			operator = "->>"
+		}
+		result[i] = operator + q.bind(key)
+	}
+	return result
+}
+
+func (q *conditionQueryBuilder) createPlainValue() string {
+	if !q.valueRef.v.IsValid() {
+		return ""
+	}
+	if q.valueRef.kind == reflect.Slice {
+		return q.createSliceValue(q.valueRef.v)
+	}
+	return q.createValueWithUnknownColumn(q.valueRef)
+}
+
+func (q *conditionQueryBuilder) createAnyConditionValue() string {
+	if !q.valueRef.v.IsValid() {
+		return ""
+	}
+	if !q.isArrayColumn() && (q.valueRef.kind == reflect.Slice || q.valueRef.kind == reflect.Array) {
+		return q.bind(createArrayValue(q.valueRef.v.Interface()))
+	}
+	return q.createPlainValue()
+}
+
+func (q *conditionQueryBuilder) isArrayColumn() bool {
+	column := q.getColumn()
+	return column != nil && q.subquery == nil && len(q.path) == 0 && isArrayType(column.dataType)
+}
+
 func (q *conditionQueryBuilder) getColumn() *column {
 	for _, c := range q.entity.columns {
 		if c.name == q.column {
@@ -32,6 +32,7 @@ func createWithQuery(name string) *withQueryBuilder {
 func (q *withQueryBuilder) createQueryString() string {
 	result := make([]string, 0)
 	result = append(result, q.name, "AS")
+	q.shareArgs(q.query.queryBuilder)
 	result = append(result, "("+strings.TrimSuffix(q.query.createQueryString(), q.getQueryDivider())+")")
 	return strings.Join(result, " ")
 }